@@ -0,0 +1,57 @@
+package igo
+
+/*
+The correlation endpoint compares NDT measurements with a census variable
+across the counties of a state, e.g.
+/correlation?state=NC&variable=B19013_001E:Median household income&start=2012-01-01&end=2012-06-30
+*/
+
+import (
+	"appengine"
+	"impact/data/correlation"
+	"impact/data/model"
+	"net/http"
+	"time"
+)
+
+func init() {
+	http.HandleFunc("/correlation", correlate)
+}
+
+// Answers with how throughput and RTT vary with the variable, county by county
+func correlate(w http.ResponseWriter, r *http.Request) {
+	req, err := correlation.ParseRequest(r, time.Now().UTC())
+	if err != nil {
+		writeCorrelationError(w, r, err)
+		return
+	}
+	result, err := correlation.Correlate(r, req)
+	if err != nil {
+		writeCorrelationError(w, r, err)
+		return
+	}
+	writeJSON(w, result)
+}
+
+// Answers with the error of a correlation request and a matching status code
+func writeCorrelationError(w http.ResponseWriter, r *http.Request, err error) {
+	code := http.StatusInternalServerError
+	if sourceErr, ok := err.(*model.SourceError); ok {
+		switch sourceErr.Code {
+		case model.ErrCodeInvalidRequest:
+			code = http.StatusBadRequest
+		case model.ErrCodeUnavailable:
+			code = http.StatusServiceUnavailable
+		case model.ErrCodeTimeout:
+			code = http.StatusGatewayTimeout
+		}
+	} else {
+		err = model.NewSourceError(model.ErrCodeFailed, err)
+	}
+	if code != http.StatusBadRequest {
+		c := appengine.NewContext(r)
+		c.Errorf("correlate(%v) err = %v", r.FormValue("state"), err)
+	}
+	w.WriteHeader(code)
+	writeJSON(w, err)
+}
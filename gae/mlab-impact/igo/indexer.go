@@ -0,0 +1,75 @@
+package igo
+
+/*
+The indexer backend precomputes monthly NDT aggregates so that queries for
+common locations do not wait on BigQuery. cron.yaml runs scheduleIndexer at
+the start of each month, which queues a task per month on the indexer queue
+for the indexer backend to run.
+*/
+
+import (
+	"appengine"
+	"appengine/taskqueue"
+	"fmt"
+	"impact/data/ndt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const indexerName = "indexer"
+
+func init() {
+	http.HandleFunc("/indexer/schedule", scheduleIndexer)
+	http.HandleFunc("/indexer/month", indexMonth)
+}
+
+/*
+Queues the indexing of the given months, or of last month by default.
+
+Administrators can backfill with e.g. /indexer/schedule?month=2012-05&month=2012-06
+*/
+func scheduleIndexer(w http.ResponseWriter, r *http.Request) {
+	c := appengine.NewContext(r)
+	r.ParseForm()
+	months := r.Form["month"]
+	if len(months) == 0 {
+		lastMonth := time.Now().UTC().AddDate(0, -1, 0)
+		months = []string{lastMonth.Format(ndt.MonthFormat)}
+	}
+
+	for _, month := range months {
+		if _, err := ndt.ParseMonth(month); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		task := taskqueue.NewPOSTTask("/indexer/month", url.Values{"month": {month}})
+		task.Header.Set("Host", appengine.BackendHostname(c, indexerName, -1))
+		if _, err := taskqueue.Add(c, task, indexerName); err != nil {
+			c.Errorf("scheduleIndexer:taskqueue.Add(%v) err = %v", month, err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprintf(w, "queued %v\n", month)
+	}
+}
+
+// Indexes one month; failing makes the indexer queue retry it
+func indexMonth(w http.ResponseWriter, r *http.Request) {
+	c := appengine.NewContext(r)
+	month, err := ndt.ParseMonth(r.FormValue("month"))
+	if err != nil {
+		c.Errorf("indexMonth:ndt.ParseMonth err = %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	count, err := ndt.NDT_Source().IndexMonth(r, month)
+	if err != nil {
+		c.Errorf("indexMonth:IndexMonth(%v) err = %v", r.FormValue("month"), err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	c.Infof("indexed %v aggregates for %v", count, r.FormValue("month"))
+	fmt.Fprintf(w, "indexed %v aggregates\n", count)
+}
@@ -11,9 +11,13 @@ import ( // for docs http://golang.org/pkg/ pkgname
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"impact/data"
+	"impact/data/census"
+	"impact/data/model"
 	"impact/data/ndt"
 	"impact/queryHandler"
 	"net/http"
+	"strconv"
 	"strings"
 	"text/template"
 	"time"
@@ -85,6 +89,13 @@ func init() {
 	http.HandleFunc("/", root)
 	http.HandleFunc("/query", query)
 	http.HandleFunc("/bq_job", bigqueryJob)
+	http.HandleFunc("/jobs/status", jobStatus)
+	http.HandleFunc("/jobs/results", jobResults)
+	http.HandleFunc("/jobs/cancel", cancelJob)
+	http.HandleFunc("/sources", listSources)
+	http.HandleFunc("/admin/sources", adminSources)
+	http.HandleFunc("/admin/datasets", adminDatasets)
+	http.HandleFunc("/admin/variables", adminVariables)
 	//http.HandleFunc("/oauth2callback", oauth2callback)
 	http.HandleFunc("/admin/logout", logout)
 	http.HandleFunc("/user/logout", logout)
@@ -179,34 +190,120 @@ func login(w http.ResponseWriter, r *http.Request) {
 func query(w http.ResponseWriter, r *http.Request) {
 
 	result, err := queryHandler.GetResult(r)
+	if sourceErr, ok := err.(*model.SourceError); ok && sourceErr.Code == model.ErrCodeInvalidRequest {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, err)
+		return
+	}
 	if err != nil {
 		fmt.Fprint(w, err.Error())
 		//http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	b, err := json.Marshal(result)
+	if summary, sources := data.CacheHeaders(result); summary != "" {
+		w.Header().Set("X-Impact-Cache", summary)
+		w.Header().Set("X-Impact-Cache-Sources", sources)
+	}
+	writeJSON(w, result)
+}
 
-	if err == nil {
-		buffer := bytes.NewBuffer(b)
-		json := buffer.String()
+/*Answers the polling of the original front end with the first page of a job.
 
-		fmt.Fprint(w, json)
-	} else {
+New clients use /jobs/status and /jobs/results instead.
+*/
+func bigqueryJob(w http.ResponseWriter, r *http.Request) {
+	page, err := ndt.NDT_Source().JobResults(r, r.FormValue("jobID"), 0, 0)
+	if err != nil {
 		fmt.Fprint(w, err.Error())
-		//http.Error(w, "Error marshalling JSON values", 400)
+		return
+	}
+
+	if page.Trend != nil {
+		writeJSON(w, page.Trend)
+	} else {
+		writeJSON(w, page.Network)
 	}
 }
 
-func bigqueryJob(w http.ResponseWriter, r *http.Request) {
-	jobID := r.FormValue("jobID")
+func jobStatus(w http.ResponseWriter, r *http.Request) {
+	status, err := ndt.NDT_Source().JobStatus(r, r.FormValue("jobID"))
+	if err != nil {
+		writeJobError(w, r, err)
+		return
+	}
+	writeJSON(w, status)
+}
+
+/*Answers with a page of the rows of a job.
 
-	result, err := ndt.NDT_Source().JobResult(r, jobID)
+startIndex is the first row to read and maxResults the size of the page.
+*/
+func jobResults(w http.ResponseWriter, r *http.Request) {
+	var startIndex uint64
+	var maxResults int64
+	var err error
+	if value := r.FormValue("startIndex"); value != "" {
+		if startIndex, err = strconv.ParseUint(value, 10, 64); err != nil {
+			http.Error(w, "Unparseable startIndex", http.StatusBadRequest)
+			return
+		}
+	}
+	if value := r.FormValue("maxResults"); value != "" {
+		if maxResults, err = strconv.ParseInt(value, 10, 64); err != nil {
+			http.Error(w, "Unparseable maxResults", http.StatusBadRequest)
+			return
+		}
+	}
 
+	page, err := ndt.NDT_Source().JobResults(r, r.FormValue("jobID"), startIndex, maxResults)
 	if err != nil {
-		fmt.Fprint(w, err.Error())
+		writeJobError(w, r, err)
+		return
+	}
+	writeJSON(w, page)
+}
+
+/*Cancels a job on BigQuery.
+
+Only the signed in user who submitted the job or an administrator may.
+*/
+func cancelJob(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "Jobs are cancelled with a POST", http.StatusMethodNotAllowed)
 		return
 	}
+	job, err := ndt.NDT_Source().CancelJob(r, r.FormValue("jobID"))
+	if err != nil {
+		writeJobError(w, r, err)
+		return
+	}
+	writeJSON(w, job)
+}
 
+// Answers with the error of a job request and a matching status code
+func writeJobError(w http.ResponseWriter, r *http.Request, err error) {
+	code := http.StatusInternalServerError
+	if sourceErr, ok := err.(*model.SourceError); ok {
+		switch sourceErr.Code {
+		case model.ErrCodeNotFound:
+			code = http.StatusNotFound
+		case model.ErrCodeCancelled:
+			code = http.StatusGone
+		case model.ErrCodeForbidden:
+			code = http.StatusForbidden
+		case model.ErrCodeUnavailable:
+			code = http.StatusServiceUnavailable
+		}
+	} else {
+		c := appengine.NewContext(r)
+		c.Errorf("job %v err = %v", r.FormValue("jobID"), err)
+		err = model.NewSourceError(model.ErrCodeFailed, err)
+	}
+	w.WriteHeader(code)
+	writeJSON(w, err)
+}
+
+func writeJSON(w http.ResponseWriter, result interface{}) {
 	b, err := json.Marshal(result)
 
 	if err == nil {
@@ -220,6 +317,87 @@ func bigqueryJob(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func listSources(w http.ResponseWriter, r *http.Request) {
+	infos, err := data.DescribeSources(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, infos)
+}
+
+/*Turns sources on or off without a redeploy.
+
+A POST with name and enabled=true|false changes the setting for that source.
+Every request answers with the resulting list of sources.
+*/
+func adminSources(w http.ResponseWriter, r *http.Request) {
+	c := appengine.NewContext(r)
+	if r.Method == "POST" {
+		name := r.FormValue("name")
+		enabled := r.FormValue("enabled") == "true"
+		if err := data.SetSourceEnabled(r, name, enabled); err != nil {
+			c.Errorf("adminSources:data.SetSourceEnabled(%v) err = %v", name, err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		c.Infof("source %v enabled = %v", name, enabled)
+	}
+	listSources(w, r)
+}
+
+/*Picks the census dataset each source queries when a request names none.
+
+A POST with source and dataset, e.g. source=ACS&dataset=2012/acs5, changes
+the setting for that source. Every request answers with the catalogs of
+datasets and the current settings.
+*/
+func adminDatasets(w http.ResponseWriter, r *http.Request) {
+	c := appengine.NewContext(r)
+	if r.Method == "POST" {
+		source := r.FormValue("source")
+		dataset := r.FormValue("dataset")
+		if err := census.SetDeploymentDataset(r, source, dataset); err != nil {
+			c.Errorf("adminDatasets:census.SetDeploymentDataset(%v, %v) err = %v", source, dataset, err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		c.Infof("source %v dataset = %v", source, dataset)
+	}
+	infos, err := census.DescribeCatalogs(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, infos)
+}
+
+/*Checks the variable catalog of a census dataset against the Census API.
+
+Takes source and dataset, e.g. source=SF1&dataset=2010/sf1, and answers with
+the variable codes the dataset's metadata does not list.
+*/
+func adminVariables(w http.ResponseWriter, r *http.Request) {
+	c := appengine.NewContext(r)
+	catalog, ok := census.LookupCatalog(r.FormValue("source"))
+	if !ok {
+		http.Error(w, "Unknown census source", http.StatusBadRequest)
+		return
+	}
+	dataset, ok := catalog.Lookup(r.FormValue("dataset"))
+	if !ok {
+		http.Error(w, "Unknown census dataset", http.StatusBadRequest)
+		return
+	}
+	unknown, err := census.CheckVariables(r, dataset)
+	if err != nil {
+		c.Errorf("adminVariables:census.CheckVariables(%v) err = %v", dataset.Key(), err)
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	writeJSON(w, map[string]interface{}{"dataset": dataset, "unknown": unknown})
+}
+
 func root(w http.ResponseWriter, r *http.Request) {
 	gt := time.Now().Unix()
 	c := appengine.NewContext(r)
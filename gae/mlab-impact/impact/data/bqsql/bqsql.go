@@ -0,0 +1,203 @@
+/*
+Package builds BigQuery SQL queries without pasting raw values into them.
+
+Values only enter a query through String, Int or Float, which quote and
+escape them, and identifiers through Field and Table, which refuse anything
+that is not a plain name. Everything else composes those into expressions.
+
+USAGE:
+
+	city := bqsql.Field("connection_spec.client_geolocation.city")
+	query := bqsql.Select(bqsql.Count()).
+		From(bqsql.Table("m_lab", "2012_06")).
+		Where(bqsql.Eq(city, bqsql.String(userCity))).
+		String()
+*/
+package bqsql
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// A fragment of SQL that is safe to place in a query
+type Expr string
+
+// Constant expressions
+const (
+	Null  Expr = "NULL"
+	True  Expr = "true"
+	False Expr = "false"
+)
+
+var identifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`)
+var tablePattern = regexp.MustCompile(`^[A-Za-z0-9_\-:]+$`)
+
+// Reports whether name can be used as a field with Field
+func ValidIdentifier(name string) bool {
+	return identifierPattern.MatchString(name)
+}
+
+// A possibly nested field such as web100_log_entry.snap.MinRTT
+func Field(name string) Expr {
+	if !ValidIdentifier(name) {
+		panic(fmt.Sprintf("bqsql: invalid field name %q", name))
+	}
+	return Expr(name)
+}
+
+// A reference to table in dataset, e.g. [m_lab.2012_06] or
+// [measurement-lab:m_lab.2012_06]
+func Table(dataset string, table string) Expr {
+	if !tablePattern.MatchString(dataset) || !tablePattern.MatchString(table) {
+		panic(fmt.Sprintf("bqsql: invalid table name %q.%q", dataset, table))
+	}
+	return Expr(fmt.Sprintf("[%v.%v]", dataset, table))
+}
+
+var stringEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\t", `\t`,
+)
+
+// A quoted string literal
+func String(val string) Expr {
+	return Expr(`"` + stringEscaper.Replace(val) + `"`)
+}
+
+func Int(val int64) Expr {
+	return Expr(strconv.FormatInt(val, 10))
+}
+
+func Float(val float64) Expr {
+	return Expr(strconv.FormatFloat(val, 'g', -1, 64))
+}
+
+// name(args...), e.g. Func("AVG", field)
+func Func(name string, args ...Expr) Expr {
+	strs := make([]string, len(args))
+	for i, arg := range args {
+		strs[i] = string(arg)
+	}
+	return Expr(fmt.Sprintf("%v(%v)", name, strings.Join(strs, ", ")))
+}
+
+func Count() Expr {
+	return "COUNT(*)"
+}
+
+func Eq(left Expr, right Expr) Expr {
+	return Expr(fmt.Sprintf("%v=%v", left, right))
+}
+
+func Compare(left Expr, op string, right Expr) Expr {
+	switch op {
+	case "=", "!=", "<", "<=", ">", ">=":
+	default:
+		panic(fmt.Sprintf("bqsql: invalid comparison %q", op))
+	}
+	return Expr(fmt.Sprintf("%v%v%v", left, op, right))
+}
+
+/*
+ * Joins conds with AND, skipping empty ones; no conditions give "". When
+ * there is more than one, each is parenthesized so that conditions holding
+ * an OR keep their meaning.
+ */
+func And(conds ...Expr) Expr {
+	strs := []string{}
+	for _, cond := range conds {
+		if cond != "" {
+			strs = append(strs, string(cond))
+		}
+	}
+	if len(strs) == 1 {
+		return Expr(strs[0])
+	}
+	for i := range strs {
+		strs[i] = "(" + strs[i] + ")"
+	}
+	return Expr(strings.Join(strs, " AND "))
+}
+
+func If(cond Expr, then Expr, otherwise Expr) Expr {
+	return Func("IF", cond, then, otherwise)
+}
+
+// Matches field against pattern; pattern is passed as an escaped string
+func RegexpMatch(field Expr, pattern string) Expr {
+	return Func("REGEXP_MATCH", field, String(pattern))
+}
+
+// expr AS alias
+func As(expr Expr, alias string) Expr {
+	if !ValidIdentifier(alias) || strings.Contains(alias, ".") {
+		panic(fmt.Sprintf("bqsql: invalid alias %q", alias))
+	}
+	return Expr(fmt.Sprintf("%v AS %v", expr, alias))
+}
+
+// A SELECT query composed one clause at a time
+type Query struct {
+	fields  []Expr
+	tables  []Expr
+	where   []Expr
+	groupBy []Expr
+	orderBy []Expr
+}
+
+func Select(fields ...Expr) *Query {
+	return &Query{fields: fields}
+}
+
+// Tables are queried as their union
+func (q *Query) From(tables ...Expr) *Query {
+	q.tables = append(q.tables, tables...)
+	return q
+}
+
+// Conditions are combined with AND
+func (q *Query) Where(conds ...Expr) *Query {
+	q.where = append(q.where, conds...)
+	return q
+}
+
+func (q *Query) GroupBy(fields ...Expr) *Query {
+	q.groupBy = append(q.groupBy, fields...)
+	return q
+}
+
+func (q *Query) OrderBy(fields ...Expr) *Query {
+	q.orderBy = append(q.orderBy, fields...)
+	return q
+}
+
+func join(exprs []Expr) string {
+	strs := make([]string, len(exprs))
+	for i, expr := range exprs {
+		strs[i] = string(expr)
+	}
+	return strings.Join(strs, ", ")
+}
+
+func (q *Query) String() string {
+	query := fmt.Sprintf("SELECT %v", join(q.fields))
+	if len(q.tables) > 0 {
+		query = fmt.Sprintf("%v FROM %v", query, join(q.tables))
+	}
+	if where := And(q.where...); where != "" {
+		query = fmt.Sprintf("%v WHERE %v", query, where)
+	}
+	if len(q.groupBy) > 0 {
+		query = fmt.Sprintf("%v GROUP BY %v", query, join(q.groupBy))
+	}
+	if len(q.orderBy) > 0 {
+		query = fmt.Sprintf("%v ORDER BY %v", query, join(q.orderBy))
+	}
+	return query
+}
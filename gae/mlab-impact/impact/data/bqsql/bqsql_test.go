@@ -0,0 +1,89 @@
+package bqsql
+
+// Unit tests for the query builder.
+
+import (
+	"testing"
+)
+
+// Makes sure that string literals cannot be broken out of.
+func TestString(t *testing.T) {
+	tests := map[string]Expr{
+		`Durham`:         `"Durham"`,
+		`say "hi"`:       `"say \"hi\""`,
+		`back\slash`:     `"back\\slash"`,
+		`end\`:           `"end\\"`,
+		"two\nlines":     `"two\nlines"`,
+		`\" OR "1"="1`:   `"\\\" OR \"1\"=\"1"`,
+		`Coeur d'Alene`:  `"Coeur d'Alene"`,
+		`São Paulo`:      `"São Paulo"`,
+		``:               `""`,
+		"tab\tseparated": `"tab\tseparated"`,
+	}
+	for val, expected := range tests {
+		if got := String(val); got != expected {
+			t.Errorf("TestString(%q) = %v, expected %v", val, got, expected)
+		}
+	}
+}
+
+// Makes sure that only plain names are accepted as fields.
+func TestValidIdentifier(t *testing.T) {
+	valid := []string{"MinRTT", "web100_log_entry.snap.MinRTT", "_private"}
+	invalid := []string{"", "1field", "a b", "a.", "a;DROP", `a"`, "a..b", "count(*)"}
+	for _, name := range valid {
+		if !ValidIdentifier(name) {
+			t.Errorf("TestValidIdentifier rejected %q", name)
+		}
+	}
+	for _, name := range invalid {
+		if ValidIdentifier(name) {
+			t.Errorf("TestValidIdentifier accepted %q", name)
+		}
+	}
+}
+
+// Makes sure that invalid fields are refused.
+func TestFieldPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("TestFieldPanics accepted an invalid field")
+		}
+	}()
+	Field("snap.MinRTT) FROM secrets --")
+}
+
+// Makes sure that the clauses are put together in order.
+func TestQueryString(t *testing.T) {
+	city := Field("connection_spec.client_geolocation.city")
+	query := Select(Count(), Func("AVG", Field("snap.MinRTT"))).
+		From(Table("m_lab", "2012_05"), Table("m_lab", "2012_06")).
+		Where(Eq(city, String("Durham")), "", Compare(Field("snap.MinRTT"), ">", Int(0))).
+		GroupBy(city).
+		String()
+
+	expected := `SELECT COUNT(*), AVG(snap.MinRTT) FROM [m_lab.2012_05], [m_lab.2012_06] ` +
+		`WHERE (connection_spec.client_geolocation.city="Durham") AND (snap.MinRTT>0) ` +
+		`GROUP BY connection_spec.client_geolocation.city`
+	if query != expected {
+		t.Errorf("TestQueryString got %v", query)
+	}
+
+	if query := Select(Count()).From(Table("m_lab", "2012_06")).String(); query != "SELECT COUNT(*) FROM [m_lab.2012_06]" {
+		t.Errorf("TestQueryString got %v", query)
+	}
+}
+
+// Makes sure that joined conditions holding an OR keep their precedence.
+func TestAnd(t *testing.T) {
+	sites := Expr(`REGEXP_MATCH(host, "lga01") OR REGEXP_MATCH(host, "atl01")`)
+	if and := And("", Eq(Field("country"), String("US")), sites); and != `(country="US") AND (`+sites+`)` {
+		t.Errorf("TestAnd got %v", and)
+	}
+	if and := And("", sites); and != sites {
+		t.Errorf("TestAnd wrapped a single condition: %v", and)
+	}
+	if and := And("", ""); and != "" {
+		t.Errorf("TestAnd got %v without conditions", and)
+	}
+}
@@ -0,0 +1,98 @@
+// Package caches the results of data sources between queries
+package cache
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/gob"
+	"fmt"
+	"impact/data/model"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Whether a source's result came from the cache
+const (
+	Hit  = "hit"
+	Miss = "miss"
+)
+
+/*
+ * Storage for cached results. Get reports false for keys that are missing or
+ * have expired. Backends that do not need the request may ignore it.
+ */
+type Backend interface {
+	Get(r *http.Request, key string) ([]byte, bool, error)
+	Set(r *http.Request, key string, value []byte, ttl time.Duration) error
+}
+
+// Stores source results in a Backend
+type Cache struct {
+	Backend Backend
+}
+
+func New(backend Backend) *Cache {
+	return &Cache{Backend: backend}
+}
+
+/*
+ * Builds the key of a query to source from its normalized parts. The key is
+ * hashed to stay within the key length limit of memcache.
+ */
+func Key(source string, parts ...string) string {
+	normalized := make([]string, len(parts))
+	for i, part := range parts {
+		normalized[i] = strings.ToLower(strings.TrimSpace(part))
+	}
+	hash := sha1.New()
+	fmt.Fprintf(hash, "%q", normalized)
+	return fmt.Sprintf("%v:%x", strings.ToLower(source), hash.Sum(nil))
+}
+
+// The parts of a location that pick the data a source returns
+func LocationKey(loc *model.Location) string {
+	if loc == nil {
+		return ""
+	}
+	parts := []string{loc.Country, loc.Region, loc.County, loc.City, loc.Zip, loc.Site, loc.Metro}
+	for i, part := range parts {
+		parts[i] = strings.ToLower(strings.TrimSpace(part))
+	}
+	return strings.Join(parts, "/")
+}
+
+// Looks up the value cached under key, decoding it into value, a pointer
+func (c *Cache) Get(r *http.Request, key string, value interface{}) (bool, error) {
+	data, ok, err := c.Backend.Get(r, key)
+	if err != nil || !ok {
+		return false, err
+	}
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(value); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Caches value under key for ttl
+func (c *Cache) Set(r *http.Request, key string, value interface{}, ttl time.Duration) error {
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(value); err != nil {
+		return err
+	}
+	return c.Backend.Set(r, key, buffer.Bytes(), ttl)
+}
+
+// Looks up the result cached under key
+func (c *Cache) GetResult(r *http.Request, key string) (*model.Result, bool, error) {
+	result := &model.Result{}
+	if ok, err := c.Get(r, key, result); err != nil || !ok {
+		return nil, false, err
+	}
+	return result, true, nil
+}
+
+// Caches result under key for ttl
+func (c *Cache) SetResult(r *http.Request, key string, result *model.Result, ttl time.Duration) error {
+	return c.Set(r, key, result, ttl)
+}
@@ -0,0 +1,126 @@
+package cache
+
+// Unit tests for the result cache and its backends.
+
+import (
+	"errors"
+	"impact/data/model"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// Makes sure that keys ignore case and surrounding spaces but not content.
+func TestKey(t *testing.T) {
+	if Key("NDT", " Durham ", "NC") != Key("ndt", "durham", "nc") {
+		t.Errorf("TestKey did not normalize the parts")
+	}
+	if Key("NDT", "a", "b") == Key("NDT", "a|b") {
+		t.Fail()
+	}
+	if Key("NDT", "durham") == Key("ACS", "durham") {
+		t.Errorf("TestKey shared keys between sources")
+	}
+}
+
+// Makes sure that the least recently used entry is dropped when full.
+func TestLRUEviction(t *testing.T) {
+	lru := NewLRU(2)
+	lru.Set(nil, "a", []byte("1"), time.Hour)
+	lru.Set(nil, "b", []byte("2"), time.Hour)
+	lru.Get(nil, "a")
+	lru.Set(nil, "c", []byte("3"), time.Hour)
+
+	if _, ok, _ := lru.Get(nil, "b"); ok {
+		t.Errorf("TestLRUEviction kept the least recently used entry")
+	}
+	if value, ok, _ := lru.Get(nil, "a"); !ok || string(value) != "1" {
+		t.Errorf("TestLRUEviction lost a = %s", value)
+	}
+	if lru.Len() != 2 {
+		t.Errorf("TestLRUEviction holds %v entries", lru.Len())
+	}
+}
+
+// Makes sure that entries are not returned past their TTL.
+func TestLRUExpiry(t *testing.T) {
+	now := time.Date(2012, 7, 3, 12, 0, 0, 0, time.UTC)
+	lru := NewLRU(2)
+	lru.Now = func() time.Time { return now }
+	lru.Set(nil, "a", []byte("1"), time.Minute)
+
+	if _, ok, _ := lru.Get(nil, "a"); !ok {
+		t.Errorf("TestLRUExpiry lost a fresh entry")
+	}
+	now = now.Add(time.Minute)
+	if _, ok, _ := lru.Get(nil, "a"); ok {
+		t.Errorf("TestLRUExpiry returned an expired entry")
+	}
+	if lru.Len() != 0 {
+		t.Errorf("TestLRUExpiry kept the expired entry")
+	}
+}
+
+// Makes sure that results come back whole from the cache.
+func TestResultRoundTrip(t *testing.T) {
+	c := New(Tiered{NewLRU(1)})
+	table := model.NewCensusTable()
+	table.Space("Income - Less than $10,000").Value = &model.CensusValue{Total: 12}
+	network := model.NewNetworkData()
+	network.Complete = true
+	network.Fields["MinRTT"] = &model.FieldStats{Average: 12}
+
+	err := c.SetResult(nil, "key", &model.Result{ACS: &model.CensusComparison{Client: table}, Network: network}, time.Hour)
+	if err != nil {
+		t.Fatalf("TestResultRoundTrip:SetResult err = %v", err)
+	}
+	result, ok, err := c.GetResult(nil, "key")
+	if err != nil || !ok {
+		t.Fatalf("TestResultRoundTrip:GetResult = %v, %v", ok, err)
+	}
+	income := result.ACS.Client.Children["Income"].Children["Less than $10,000"]
+	if income == nil || income.Value.Total != 12 {
+		t.Errorf("TestResultRoundTrip income = %+v", income)
+	}
+	if !result.Network.Complete || result.Network.Fields["MinRTT"].Average != 12 {
+		t.Errorf("TestResultRoundTrip network = %+v", result.Network)
+	}
+	if _, ok, _ := c.GetResult(nil, "other"); ok {
+		t.Fail()
+	}
+}
+
+// A Backend that always fails
+type failingBackend struct{}
+
+func (failingBackend) Get(r *http.Request, key string) ([]byte, bool, error) {
+	return nil, false, errors.New("memcache down")
+}
+
+func (failingBackend) Set(r *http.Request, key string, value []byte, ttl time.Duration) error {
+	return errors.New("memcache down")
+}
+
+// Makes sure that a failing tier does not hide the tiers after it.
+func TestTieredSkipsFailingTier(t *testing.T) {
+	lru := NewLRU(1)
+	lru.Set(nil, "a", []byte("1"), time.Hour)
+	if value, ok, err := (Tiered{failingBackend{}, lru}).Get(nil, "a"); err != nil || !ok || string(value) != "1" {
+		t.Errorf("TestTieredSkipsFailingTier got %s, %v, %v", value, ok, err)
+	}
+	if _, ok, err := (Tiered{failingBackend{}, NewLRU(1)}).Get(nil, "a"); ok || err == nil {
+		t.Errorf("TestTieredSkipsFailingTier hid the failure of a miss: %v, %v", ok, err)
+	}
+}
+
+// Makes sure that hits in a lower tier are copied into the tiers above.
+func TestTieredPromotesHits(t *testing.T) {
+	upper, lower := NewLRU(1), NewLRU(1)
+	lower.Set(nil, "a", []byte("1"), time.Hour)
+	if _, ok, _ := (Tiered{upper, lower}).Get(nil, "a"); !ok {
+		t.Fatalf("TestTieredPromotesHits missed a")
+	}
+	if value, ok, _ := upper.Get(nil, "a"); !ok || string(value) != "1" {
+		t.Errorf("TestTieredPromotesHits did not copy a up, got %s", value)
+	}
+}
@@ -0,0 +1,81 @@
+package cache
+
+import (
+	"container/list"
+	"net/http"
+	"sync"
+	"time"
+)
+
+type lruEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+/*
+ * An in-memory Backend holding at most Capacity entries, dropping the least
+ * recently used one when full. Entries are only shared by the requests an
+ * instance serves.
+ */
+type LRU struct {
+	Capacity int
+	// Clock used for expiry, replaceable in tests
+	Now func() time.Time
+
+	mutex   sync.Mutex
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+func NewLRU(capacity int) *LRU {
+	return &LRU{
+		Capacity: capacity,
+		Now:      time.Now,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+}
+
+func (lru *LRU) Get(r *http.Request, key string) ([]byte, bool, error) {
+	lru.mutex.Lock()
+	defer lru.mutex.Unlock()
+
+	element, ok := lru.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	entry := element.Value.(*lruEntry)
+	if !lru.Now().Before(entry.expires) {
+		lru.order.Remove(element)
+		delete(lru.entries, key)
+		return nil, false, nil
+	}
+	lru.order.MoveToFront(element)
+	return entry.value, true, nil
+}
+
+func (lru *LRU) Set(r *http.Request, key string, value []byte, ttl time.Duration) error {
+	lru.mutex.Lock()
+	defer lru.mutex.Unlock()
+
+	entry := &lruEntry{key: key, value: value, expires: lru.Now().Add(ttl)}
+	if element, ok := lru.entries[key]; ok {
+		element.Value = entry
+		lru.order.MoveToFront(element)
+		return nil
+	}
+	lru.entries[key] = lru.order.PushFront(entry)
+	for lru.order.Len() > lru.Capacity {
+		oldest := lru.order.Back()
+		lru.order.Remove(oldest)
+		delete(lru.entries, oldest.Value.(*lruEntry).key)
+	}
+	return nil
+}
+
+func (lru *LRU) Len() int {
+	lru.mutex.Lock()
+	defer lru.mutex.Unlock()
+	return lru.order.Len()
+}
@@ -0,0 +1,34 @@
+package cache
+
+import (
+	"appengine"
+	"appengine/memcache"
+	"net/http"
+	"time"
+)
+
+// A Backend shared by every instance of the app, through App Engine memcache
+type Memcache struct {
+	Prefix string
+}
+
+func (m *Memcache) Get(r *http.Request, key string) ([]byte, bool, error) {
+	c := appengine.NewContext(r)
+	item, err := memcache.Get(c, m.Prefix+key)
+	if err == memcache.ErrCacheMiss {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return item.Value, true, nil
+}
+
+func (m *Memcache) Set(r *http.Request, key string, value []byte, ttl time.Duration) error {
+	c := appengine.NewContext(r)
+	return memcache.Set(c, &memcache.Item{
+		Key:        m.Prefix + key,
+		Value:      value,
+		Expiration: ttl,
+	})
+}
@@ -0,0 +1,59 @@
+package cache
+
+import (
+	"appengine"
+	"net/http"
+	"time"
+)
+
+/*
+ * How long a value found in a lower tier is kept in the tiers above it. The
+ * lower tier does not tell how long the value has left, so this is kept
+ * short.
+ */
+var PromotedTTL = 10 * time.Minute
+
+/*
+ * Looks entries up in each backend in turn and stores them in all of them,
+ * e.g. an LRU in front of Memcache. A backend that fails is logged and
+ * skipped, and entries found in a lower tier are copied into the tiers
+ * above it.
+ */
+type Tiered []Backend
+
+func (t Tiered) Get(r *http.Request, key string) ([]byte, bool, error) {
+	var lastErr error
+	for i, backend := range t {
+		value, ok, err := backend.Get(r, key)
+		if err != nil {
+			logError(r, "Tiered.Get(%v) tier %v err = %v", key, i, err)
+			lastErr = err
+			continue
+		}
+		if ok {
+			for _, upper := range t[:i] {
+				upper.Set(r, key, value, PromotedTTL)
+			}
+			return value, true, nil
+		}
+	}
+	return nil, false, lastErr
+}
+
+func (t Tiered) Set(r *http.Request, key string, value []byte, ttl time.Duration) error {
+	for _, backend := range t {
+		if err := backend.Set(r, key, value, ttl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Tests run without a request to log to
+func logError(r *http.Request, format string, args ...interface{}) {
+	if r == nil {
+		return
+	}
+	c := appengine.NewContext(r)
+	c.Errorf(format, args...)
+}
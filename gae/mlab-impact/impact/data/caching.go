@@ -0,0 +1,100 @@
+package data
+
+import (
+	"impact/data/cache"
+	"impact/data/model"
+	"impact/data/registry"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Entries kept in memory by each instance in front of memcache
+const DefaultCacheSize = 256
+
+// Cache shared by the sources, or nil to always query them
+var ResultCache = cache.New(cache.Tiered{
+	cache.NewLRU(DefaultCacheSize),
+	&cache.Memcache{Prefix: "result:"},
+})
+
+/*
+ * Sources whose results may be cached implement this interface. CacheKey
+ * returns the normalized parts of the query that pick the result, or false
+ * when the query should not be cached, and CacheTTL how long a result stays
+ * fresh.
+ */
+type CachedSource interface {
+	CacheKey(r *http.Request, clientLoc *model.Location, serverLoc *model.Location) (string, bool)
+	CacheTTL() time.Duration
+}
+
+/*
+ * Queries source through c, reporting whether the result was a cache.Hit or
+ * a cache.Miss, or "" when the source is not cached. The cache is best
+ * effort: failing to read or write it never fails the query.
+ */
+func cachedQuery(r *http.Request, c *cache.Cache, source registry.Source, clientLoc *model.Location, serverLoc *model.Location) (*model.Result, string, error) {
+	cs, ok := source.(CachedSource)
+	if !ok || c == nil {
+		result, err := source.Query(r, clientLoc, serverLoc)
+		return result, "", err
+	}
+	parts, ok := cs.CacheKey(r, clientLoc, serverLoc)
+	if !ok {
+		result, err := source.Query(r, clientLoc, serverLoc)
+		return result, "", err
+	}
+
+	key := cache.Key(source.Name(), parts)
+	if result, hit, err := c.GetResult(r, key); err == nil && hit {
+		return result, cache.Hit, nil
+	}
+	result, err := source.Query(r, clientLoc, serverLoc)
+	if err == nil && cacheable(result) {
+		c.SetResult(r, key, result, cs.CacheTTL())
+	}
+	return result, cache.Miss, err
+}
+
+// Only whole results are cached, never pending BigQuery jobs or census comparisons missing a side
+func cacheable(result *model.Result) bool {
+	if result == nil || result.Partial || len(result.Errors) > 0 {
+		return false
+	}
+	for _, comparison := range []*model.CensusComparison{result.ACS, result.SF1} {
+		if comparison != nil && !comparison.Complete() {
+			return false
+		}
+	}
+	if network := result.Network; network != nil {
+		if !network.Complete || (network.Trend != nil && !network.Trend.Complete) {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+ * Summarizes the cache statuses of a result for the response headers: hit or
+ * miss when every cached source agrees and partial otherwise, followed by
+ * the status of each source, e.g. "ACS=hit, NDT=miss".
+ */
+func CacheHeaders(result *model.Result) (string, string) {
+	if len(result.Cache) == 0 {
+		return "", ""
+	}
+	summary := ""
+	sources := []string{}
+	for source, status := range result.Cache {
+		if summary == "" {
+			summary = status
+		} else if summary != status {
+			summary = "partial"
+		}
+		sources = append(sources, source+"="+status)
+	}
+	sort.Strings(sources)
+	return summary, strings.Join(sources, ", ")
+}
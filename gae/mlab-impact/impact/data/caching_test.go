@@ -0,0 +1,95 @@
+package data
+
+// Unit tests for caching source results.
+
+import (
+	"errors"
+	"impact/data/cache"
+	"impact/data/model"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// A test source that counts its queries and may be cached.
+type cachedTestSource struct {
+	testSource
+	queries int
+}
+
+func (cs *cachedTestSource) Query(r *http.Request, clientLoc *model.Location, serverLoc *model.Location) (*model.Result, error) {
+	cs.queries++
+	return cs.testSource.Query(r, clientLoc, serverLoc)
+}
+
+func (cs *cachedTestSource) CacheKey(r *http.Request, clientLoc *model.Location, serverLoc *model.Location) (string, bool) {
+	return cache.LocationKey(clientLoc), true
+}
+
+func (cs *cachedTestSource) CacheTTL() time.Duration {
+	return time.Hour
+}
+
+// Makes sure that a repeated query is answered from the cache.
+func TestCachedQuery(t *testing.T) {
+	c := cache.New(cache.NewLRU(4))
+	source := &cachedTestSource{testSource: testSource{name: "ACS", result: &model.Result{ACS: &model.CensusComparison{}}}}
+	loc := &model.Location{Country: "United States", Region: "North Carolina"}
+
+	if _, status, _ := cachedQuery(nil, c, source, loc, loc); status != cache.Miss {
+		t.Errorf("TestCachedQuery first status = %v", status)
+	}
+	result, status, err := cachedQuery(nil, c, source, &model.Location{Country: "united states ", Region: "north carolina"}, loc)
+	if err != nil || status != cache.Hit || result.ACS == nil {
+		t.Errorf("TestCachedQuery second query = %v, %v, %v", result, status, err)
+	}
+	if source.queries != 1 {
+		t.Errorf("TestCachedQuery ran the source %v times", source.queries)
+	}
+
+	if _, status, _ := cachedQuery(nil, nil, source, loc, loc); status != "" || source.queries != 2 {
+		t.Errorf("TestCachedQuery without a cache = %v", status)
+	}
+}
+
+// Makes sure that failures and pending jobs are not cached.
+func TestCacheable(t *testing.T) {
+	if cacheable(&model.Result{Partial: true}) {
+		t.Fail()
+	}
+	pending := model.NewNetworkData()
+	pending.JobID = "job_1"
+	if cacheable(&model.Result{Network: pending}) {
+		t.Errorf("TestCacheable cached a pending job")
+	}
+	complete := model.NewNetworkData()
+	complete.Complete = true
+	if !cacheable(&model.Result{Network: complete}) {
+		t.Errorf("TestCacheable refused a complete result")
+	}
+	comparison := &model.CensusComparison{ServerError: model.NewSourceError(model.ErrCodeUnavailable, errors.New("census down"))}
+	if cacheable(&model.Result{ACS: comparison}) {
+		t.Errorf("TestCacheable cached a census comparison missing a side")
+	}
+
+	c := cache.New(cache.NewLRU(4))
+	source := &cachedTestSource{testSource: testSource{name: "NDT", err: errors.New("bigquery down")}}
+	cachedQuery(nil, c, source, &model.Location{}, &model.Location{})
+	if _, status, _ := cachedQuery(nil, c, source, &model.Location{}, &model.Location{}); status != cache.Miss {
+		t.Errorf("TestCacheable cached an error")
+	}
+}
+
+// Makes sure that the headers summarize the status of every source.
+func TestCacheHeaders(t *testing.T) {
+	result := DefaultResult()
+	if summary, _ := CacheHeaders(result); summary != "" {
+		t.Fail()
+	}
+	result.SetCacheStatus("NDT", cache.Miss)
+	result.SetCacheStatus("ACS", cache.Hit)
+	summary, sources := CacheHeaders(result)
+	if summary != "partial" || sources != "ACS=hit, NDT=miss" {
+		t.Errorf("TestCacheHeaders = %v, %v", summary, sources)
+	}
+}
@@ -2,99 +2,92 @@ package acs
 
 import (
 	"impact/data/census"
+	"impact/data/model"
+	"impact/data/registry"
 	"net/http"
+	"time"
 )
 
-var (
-	DefaultFields = [][]string{
-		[]string{"B19101_002E", "Income - Less than $10,000"},
-		[]string{"B19101_003E", "Income - $10,000 to $14,999"},
-		[]string{"B19101_004E", "Income - $15,000 to $19,999"},
-		[]string{"B19101_005E", "Income - $20,000 to $24,999"},
-		[]string{"B19101_006E", "Income - $25,000 to $29,999"},
-		[]string{"B19101_007E", "Income - $30,000 to $34,999"},
-		[]string{"B19101_008E", "Income - $35,000 to $39,999"},
-		[]string{"B19101_009E", "Income - $40,000 to $44,999"},
-		[]string{"B19101_010E", "Income - $45,000 to $49,999"},
-		[]string{"B19101_011E", "Income - $50,000 to $59,999"},
-		[]string{"B19101_012E", "Income - $60,000 to $74,999"},
-		[]string{"B19101_013E", "Income - $75,000 to $99,999"},
-		[]string{"B19101_014E", "Income - $100,000 to $124,999"},
-		[]string{"B19101_015E", "Income - $125,000 to $149,999"},
-		[]string{"B19101_016E", "Income - $150,000 to $199,999"},
-		[]string{"B19101_017E", "Income - $200,000 or more"},
-
-		[]string{"B05003F_001E", "Sex by Age by Citizenship Status - Total"},
-		[]string{"B05003F_002E", "Sex by Age by Citizenship Status - Male"},
-		[]string{"B05003F_003E", "Sex by Age by Citizenship Status - Male - Under 18 years"},
-		[]string{"B05003F_004E", "Sex by Age by Citizenship Status - Male - Under 18 years - Native"},
-		[]string{"B05003F_005E", "Sex by Age by Citizenship Status - Male - Under 18 years - Foreign born"},
-		[]string{"B05003F_006E", "Sex by Age by Citizenship Status - Male - Under 18 years - Foreign born - Naturalized U.S. citizen"},
-		[]string{"B05003F_007E", "Sex by Age by Citizenship Status - Male - Under 18 years - Foreign born - Not a U.S. citizen"},
-		[]string{"B05003F_008E", "Sex by Age by Citizenship Status - Male - 18 years and over"},
-		[]string{"B05003F_009E", "Sex by Age by Citizenship Status - Male - 18 years and over - Native"},
-		[]string{"B05003F_010E", "Sex by Age by Citizenship Status - Male - 18 years and over - Foreign born"},
-		[]string{"B05003F_011E", "Sex by Age by Citizenship Status - Male - 18 years and over - Foreign born - Naturalized U.S. citizen"},
-		[]string{"B05003F_012E", "Sex by Age by Citizenship Status - Male - 18 years and over - Foreign born - Not a U.S. citizen"},
-		[]string{"B05003F_013E", "Sex by Age by Citizenship Status - Female"},
-		[]string{"B05003F_014E", "Sex by Age by Citizenship Status - Female - Under 18 years"},
-		[]string{"B05003F_015E", "Sex by Age by Citizenship Status - Female - Under 18 years - Native"},
-		[]string{"B05003F_016E", "Sex by Age by Citizenship Status - Female - Under 18 years - Foreign born"},
-		[]string{"B05003F_017E", "Sex by Age by Citizenship Status - Female - Under 18 years - Foreign born - Naturalized U.S. citizen"},
-		[]string{"B05003F_018E", "Sex by Age by Citizenship Status - Female - Under 18 years - Foreign born - Not a U.S. citizen"},
-		[]string{"B05003F_019E", "Sex by Age by Citizenship Status - Female - 18 years and over"},
-		[]string{"B05003F_020E", "Sex by Age by Citizenship Status - Female - 18 years and over - Native"},
-		[]string{"B05003F_021E", "Sex by Age by Citizenship Status - Female - 18 years and over - Foreign born"},
-		[]string{"B05003F_022E", "Sex by Age by Citizenship Status - Female - 18 years and over - Foreign born - Naturalized U.S. citizen"},
-		[]string{"B05003F_023E", "Sex by Age by Citizenship Status - Female - 18 years and over - Foreign born - Not a U.S. citizen"},
-	}
+/*
+ * The ACS releases that can be queried. Requests pick one with acsDataset,
+ * e.g. acsDataset=2012/acs1; the 1-year estimates only cover areas of 65,000
+ * people or more. acsVariables lists variables to fetch instead of topics.
+ * Estimates are reported with their margins of error. These releases number
+ * the variables alike and so share the acs variable set.
+ */
+var Datasets = census.NewCatalog("ACS", "acs", "2010/acs5",
+	&census.Dataset{Vintage: "2010", Product: "acs5", Variables: "acs", Margins: true},
+	&census.Dataset{Vintage: "2011", Product: "acs5", Variables: "acs", Margins: true},
+	&census.Dataset{Vintage: "2012", Product: "acs5", Variables: "acs", Margins: true},
+	&census.Dataset{Vintage: "2012", Product: "acs1", Variables: "acs", Margins: true},
 )
 
 type ACS struct {
 }
 
+func init() {
+	census.RegisterCatalog(Datasets)
+	registry.Register(ACS_Source())
+}
+
 func ACS_Source() *ACS {
 	a := &ACS{}
 	return a
 }
 
-func (acs *ACS) getACSResults(r *http.Request, fields [][]string, loc map[string]interface{}) (map[string]interface{}, error) {
+// Returns the figures of loc at the census geography level, and the level used
+func (acs *ACS) getACSResults(r *http.Request, dataset *census.Dataset, fields [][]string, loc *model.Location, level string) (*model.CensusTable, string, error) {
 
-	if loc["Country"] != "United States" {
-		return make(map[string]interface{}), nil
+	if len(fields) == 0 {
+		return model.NewCensusTable(), "", nil
 	}
-
-	countyName := loc["County"]
-	if countyName == nil {
-		countyName = ""
-	}
-	stateName := loc["Region"]
-	if stateName == nil {
-		stateName = ""
+	geo, level, ok := census.LocationGeography(r, loc, level)
+	if !ok {
+		return model.NewCensusTable(), "", nil
 	}
-	county, state := census.CountyAndStateCodes(countyName.(string), stateName.(string))
 	requester := census.DefaultCensusRequester()
-	return requester.AskApiInChunks(r, "http://api.census.gov/data/2010/acs5?", DefaultFields, county, state, 5)
+	requester.Margins = dataset.Margins
+	table, err := requester.AskApiInChunks(r, dataset.URL(), fields, geo, 5)
+	return table, level, err
 }
 
-func (acs *ACS) Query(r *http.Request, clientLoc map[string]interface{}, serverLoc map[string]interface{}) (map[string]interface{}, error) {
+func (acs *ACS) Name() string {
+	return "ACS"
+}
 
-	result := make(map[string]interface{})
+func (acs *ACS) Provides() []string {
+	return Datasets.Provides()
+}
 
-	acs_val := make(map[string]interface{})
-	result["ACS"] = acs_val
+// Published estimates do not change, so they are cached for as long as memcache allows
+func (acs *ACS) CacheTTL() time.Duration {
+	return 30 * 24 * time.Hour
+}
+
+func (acs *ACS) CacheKey(r *http.Request, clientLoc *model.Location, serverLoc *model.Location) (string, bool) {
+	return Datasets.CacheKey(r, clientLoc, serverLoc)
+}
+
+func (acs *ACS) Query(r *http.Request, clientLoc *model.Location, serverLoc *model.Location) (*model.Result, error) {
 
-	client_acs, err := acs.getACSResults(r, DefaultFields, clientLoc)
+	dataset, err := Datasets.Select(r)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := Datasets.Fields(r, dataset)
 	if err != nil {
 		return nil, err
 	}
-	acs_val["client"] = client_acs
+	acs_val := &model.CensusComparison{Dataset: dataset.Key()}
+	result := &model.Result{ACS: acs_val}
 
-	server_acs, err := acs.getACSResults(r, DefaultFields, serverLoc)
+	level := census.RequestedGeography(r)
+	err = census.Compare(acs_val, clientLoc, serverLoc, func(loc *model.Location) (*model.CensusTable, string, error) {
+		return acs.getACSResults(r, dataset, fields, loc, level)
+	})
 	if err != nil {
 		return nil, err
 	}
-	acs_val["server"] = server_acs
 
 	return result, nil
 }
@@ -0,0 +1,185 @@
+/*
+ * Package api is a client for the Census Bureau's data API. Responses are
+ * JSON arrays of arrays: a header row naming the variables and geography
+ * columns, followed by a row per area.
+ */
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Root of the datasets of the Census API
+const BaseURL = "http://api.census.gov/data"
+
+// Longest part of an unexpected body quoted in an error
+const maxQuoted = 200
+
+var (
+	ErrNoData        = errors.New("census api: no data for this geography")
+	ErrEmptyResponse = errors.New("census api: response has no header row")
+	ErrNoVariables   = errors.New("census api: no variables requested")
+)
+
+// Returns the URL of a dataset, e.g. DatasetURL("2010", "sf1")
+func DatasetURL(vintage string, dataset string) string {
+	return fmt.Sprintf("%v/%v/%v", BaseURL, vintage, dataset)
+}
+
+/*
+ * An error reported by the Census API, with the status code of the response
+ * and the message the API gave, e.g. "error: unknown variable 'P0030099'".
+ */
+type Error struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("census api: %v %v: %v", e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+}
+
+// A response that could not be decoded
+type DecodeError struct {
+	Reason string
+	Body   string
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("census api: %v in response %q", e.Reason, e.Body)
+}
+
+func quote(body []byte) string {
+	str := strings.TrimSpace(string(body))
+	if len(str) > maxQuoted {
+		return str[:maxQuoted] + "..."
+	}
+	return str
+}
+
+/*
+ * One area of a response. Values holds the requested variables and
+ * Geography the codes of the area, keyed by level. Variables the API
+ * answered with null are missing from Values.
+ */
+type Row struct {
+	Values    map[string]string
+	Geography map[string]string
+}
+
+// Returns the value of a variable, false if the API gave none
+func (row *Row) Get(variable string) (string, bool) {
+	val, ok := row.Values[variable]
+	return val, ok
+}
+
+// A client of the Census API, fetching through HTTP
+type Client struct {
+	Key  string
+	HTTP *http.Client
+}
+
+func NewClient(key string, httpClient *http.Client) *Client {
+	return &Client{Key: key, HTTP: httpClient}
+}
+
+// Returns the request URL for variables of geo in the dataset at datasetURL
+func (c *Client) URL(datasetURL string, variables []string, geo *Geography) string {
+	values := url.Values{}
+	if c.Key != "" {
+		values.Set("key", c.Key)
+	}
+	values.Set("get", strings.Join(variables, ","))
+	geo.encode(values)
+	return datasetURL + "?" + values.Encode()
+}
+
+// Fetches variables for the areas of geo from the dataset at datasetURL
+func (c *Client) Get(datasetURL string, variables []string, geo *Geography) ([]*Row, error) {
+	if len(variables) == 0 {
+		return nil, ErrNoVariables
+	}
+	body, err := c.fetch(c.URL(datasetURL, variables, geo))
+	if err != nil {
+		return nil, err
+	}
+	return Decode(body, variables)
+}
+
+// Returns the body of a successful response, failing with ErrNoData on 204 and *Error otherwise
+func (c *Client) fetch(url string) ([]byte, error) {
+	resp, err := c.HTTP.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNoContent {
+		return nil, ErrNoData
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, &Error{StatusCode: resp.StatusCode, Message: quote(body)}
+	}
+	return body, nil
+}
+
+/*
+ * Decodes a response into rows. Columns of the header that are not among
+ * variables are taken to be geography codes.
+ */
+func Decode(body []byte, variables []string) ([]*Row, error) {
+	var table [][]*string
+	if err := json.Unmarshal(body, &table); err != nil {
+		// the API answers some bad requests with a 200 and a plain text error
+		if message := quote(body); strings.HasPrefix(message, "error:") {
+			return nil, &Error{StatusCode: http.StatusOK, Message: message}
+		}
+		return nil, &DecodeError{Reason: err.Error(), Body: quote(body)}
+	}
+	if len(table) == 0 {
+		return nil, ErrEmptyResponse
+	}
+
+	requested := make(map[string]bool, len(variables))
+	for _, variable := range variables {
+		requested[variable] = true
+	}
+	header := make([]string, len(table[0]))
+	for i, name := range table[0] {
+		if name == nil {
+			return nil, &DecodeError{Reason: fmt.Sprintf("null name for column %v", i), Body: quote(body)}
+		}
+		header[i] = *name
+	}
+
+	rows := make([]*Row, 0, len(table)-1)
+	for i, cells := range table[1:] {
+		if len(cells) != len(header) {
+			reason := fmt.Sprintf("row %v has %v columns, header has %v", i+1, len(cells), len(header))
+			return nil, &DecodeError{Reason: reason, Body: quote(body)}
+		}
+		row := &Row{Values: make(map[string]string), Geography: make(map[string]string)}
+		for j, cell := range cells {
+			if cell == nil {
+				continue
+			}
+			if requested[header[j]] {
+				row.Values[header[j]] = *cell
+			} else {
+				row.Geography[header[j]] = *cell
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
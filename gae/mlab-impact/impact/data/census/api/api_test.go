@@ -0,0 +1,125 @@
+package api
+
+// Unit tests for the Census API client.
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// Makes sure that requests name the variables and the areas asked for.
+func TestURL(t *testing.T) {
+	client := NewClient("secret", nil)
+	url := client.URL(DatasetURL("2010", "sf1"), []string{"P0030001", "P0030002"}, ForTract("37", "063", "001502"))
+	expected := "http://api.census.gov/data/2010/sf1?for=tract%3A001502&get=P0030001%2CP0030002&in=state%3A37+county%3A063&key=secret"
+	if url != expected {
+		t.Errorf("TestURL got %v, expected %v", url, expected)
+	}
+}
+
+// Makes sure that variables and geography columns are told apart.
+func TestDecode(t *testing.T) {
+	body := `[["P0030001","P0030002","state","county"],
+		["267587","113519","37","063"],
+		["1000",null,"37","065"]]`
+	rows, err := Decode([]byte(body), []string{"P0030001", "P0030002"})
+	if err != nil {
+		t.Fatalf("TestDecode:Decode err = %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("TestDecode got %v rows", len(rows))
+	}
+	if val, ok := rows[0].Get("P0030002"); !ok || val != "113519" {
+		t.Errorf("TestDecode P0030002 = %v", val)
+	}
+	if rows[0].Geography["county"] != "063" || len(rows[0].Values) != 2 {
+		t.Errorf("TestDecode first row = %+v", rows[0])
+	}
+	if _, ok := rows[1].Get("P0030002"); ok {
+		t.Errorf("TestDecode kept a null value")
+	}
+}
+
+// Makes sure that quoted commas do not split values.
+func TestDecodeQuoted(t *testing.T) {
+	rows, err := Decode([]byte(`[["NAME","state"],["Durham County, North Carolina","37"]]`), []string{"NAME"})
+	if err != nil || rows[0].Values["NAME"] != "Durham County, North Carolina" {
+		t.Errorf("TestDecodeQuoted got %v, %v", rows, err)
+	}
+}
+
+// Makes sure that malformed bodies fail with an error instead of a panic.
+func TestDecodeErrors(t *testing.T) {
+	for _, body := range []string{"", "[", `[["A"],["1","2"]]`, "<html>Service Unavailable</html>"} {
+		if _, err := Decode([]byte(body), []string{"A"}); err == nil {
+			t.Errorf("TestDecodeErrors decoded %q", body)
+		}
+	}
+	if _, err := Decode([]byte("[]"), []string{"A"}); err != ErrEmptyResponse {
+		t.Errorf("TestDecodeErrors empty table err = %v", err)
+	}
+	_, err := Decode([]byte("error: unknown variable 'P0039999'"), []string{"P0039999"})
+	if apiErr, ok := err.(*Error); !ok || !strings.Contains(apiErr.Message, "unknown variable") {
+		t.Errorf("TestDecodeErrors plain text error = %v", err)
+	}
+}
+
+// Makes sure that HTTP failures carry the status and message of the API.
+func TestGetErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.FormValue("for") {
+		case "state:99":
+			w.WriteHeader(http.StatusNoContent)
+		case "state:98":
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, "error: invalid 'for' argument")
+		default:
+			fmt.Fprint(w, `[["P0030001","state"],["9535483","37"]]`)
+		}
+	}))
+	defer server.Close()
+	client := NewClient("", http.DefaultClient)
+
+	rows, err := client.Get(server.URL, []string{"P0030001"}, ForState("37"))
+	if err != nil || len(rows) != 1 || rows[0].Values["P0030001"] != "9535483" {
+		t.Errorf("TestGetErrors got %v, %v", rows, err)
+	}
+	if _, err := client.Get(server.URL, []string{"P0030001"}, ForState("99")); err != ErrNoData {
+		t.Errorf("TestGetErrors no content err = %v", err)
+	}
+	_, err = client.Get(server.URL, []string{"P0030001"}, ForState("98"))
+	if apiErr, ok := err.(*Error); !ok || apiErr.StatusCode != http.StatusBadRequest ||
+		apiErr.Error() != "census api: 400 Bad Request: error: invalid 'for' argument" {
+		t.Errorf("TestGetErrors bad request err = %v", err)
+	}
+	if _, err := client.Get(server.URL, []string{}, ForState("37")); err != ErrNoVariables {
+		t.Errorf("TestGetErrors no variables err = %v", err)
+	}
+}
+
+// Makes sure that variable metadata is fetched from the dataset's variables.json.
+func TestVariables(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2010/sf1/variables.json" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, `{"variables": {"P0030001": {"label": "Total population", "concept": "P3. RACE [8]"}}}`)
+	}))
+	defer server.Close()
+	client := NewClient("", http.DefaultClient)
+
+	variables, err := client.Variables(server.URL + "/2010/sf1")
+	if err != nil || variables["P0030001"] == nil || variables["P0030001"].Label != "Total population" {
+		t.Errorf("TestVariables got %v, %v", variables, err)
+	}
+	if _, err := client.Variables(server.URL + "/2010/acs9"); err == nil {
+		t.Errorf("TestVariables read the metadata of a missing dataset")
+	}
+	if _, err := DecodeVariables([]byte(`{"variables": {}}`)); err != ErrEmptyResponse {
+		t.Errorf("TestVariables decoded empty metadata, err = %v", err)
+	}
+}
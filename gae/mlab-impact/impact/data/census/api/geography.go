@@ -0,0 +1,93 @@
+package api
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Geography levels of the Census API, as named in its for and in clauses
+const (
+	Nation     = "us"
+	State      = "state"
+	County     = "county"
+	Tract      = "tract"
+	BlockGroup = "block group"
+	Place      = "place"
+	ZCTA       = "zip code tabulation area"
+)
+
+// Code matching every area of a level, e.g. every county of a state
+const Wildcard = "*"
+
+// An area of a geography level, e.g. state 37
+type Area struct {
+	Level string
+	Code  string
+}
+
+func (a Area) String() string {
+	return a.Level + ":" + a.Code
+}
+
+/*
+ * The areas a request is for: Area itself, within the wider areas of In,
+ * e.g. county 063 in state 37.
+ */
+type Geography struct {
+	Area Area
+	In   []Area
+}
+
+func ForNation() *Geography {
+	return &Geography{Area: Area{Nation, "1"}}
+}
+
+func ForState(state string) *Geography {
+	return &Geography{Area: Area{State, state}}
+}
+
+func ForCounty(state string, county string) *Geography {
+	return &Geography{Area: Area{County, county}, In: []Area{{State, state}}}
+}
+
+func ForTract(state string, county string, tract string) *Geography {
+	return &Geography{Area: Area{Tract, tract}, In: []Area{{State, state}, {County, county}}}
+}
+
+func ForBlockGroup(state string, county string, tract string, blockGroup string) *Geography {
+	return &Geography{
+		Area: Area{BlockGroup, blockGroup},
+		In:   []Area{{State, state}, {County, county}, {Tract, tract}},
+	}
+}
+
+func ForPlace(state string, place string) *Geography {
+	return &Geography{Area: Area{Place, place}, In: []Area{{State, state}}}
+}
+
+// ZCTAs may cross state lines, so state is optional
+func ForZCTA(state string, zcta string) *Geography {
+	geo := &Geography{Area: Area{ZCTA, zcta}}
+	if state != "" {
+		geo.In = []Area{{State, state}}
+	}
+	return geo
+}
+
+// Adds the for and in parameters of the geography to values
+func (g *Geography) encode(values url.Values) {
+	values.Set("for", g.Area.String())
+	if len(g.In) > 0 {
+		in := make([]string, len(g.In))
+		for i, area := range g.In {
+			in[i] = area.String()
+		}
+		values.Set("in", strings.Join(in, " "))
+	}
+}
+
+func (g *Geography) String() string {
+	values := url.Values{}
+	g.encode(values)
+	return values.Encode()
+}
@@ -0,0 +1,34 @@
+package api
+
+import (
+	"encoding/json"
+)
+
+// What the metadata of a dataset says about one of its variables
+type Variable struct {
+	Label   string `json:"label"`
+	Concept string `json:"concept"`
+}
+
+// Fetches the metadata of every variable of the dataset at datasetURL, keyed by code
+func (c *Client) Variables(datasetURL string) (map[string]*Variable, error) {
+	body, err := c.fetch(datasetURL + "/variables.json")
+	if err != nil {
+		return nil, err
+	}
+	return DecodeVariables(body)
+}
+
+// Decodes the variables.json metadata document of a dataset
+func DecodeVariables(body []byte) (map[string]*Variable, error) {
+	var metadata struct {
+		Variables map[string]*Variable `json:"variables"`
+	}
+	if err := json.Unmarshal(body, &metadata); err != nil {
+		return nil, &DecodeError{Reason: err.Error(), Body: quote(body)}
+	}
+	if len(metadata.Variables) == 0 {
+		return nil, ErrEmptyResponse
+	}
+	return metadata.Variables, nil
+}
@@ -4,22 +4,40 @@ import (
 	"appengine"
 	"appengine/urlfetch"
 
-	"bytes"
-	"errors"
-	"fmt"
+	"impact/data/census/api"
+	"impact/data/model"
 	"impact/data/secrets"
-	"io/ioutil"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
+)
+
+// Chunks fetched at once for a location, and how often a failed chunk is tried
+var (
+	DefaultWorkers  = 4
+	DefaultAttempts = 3
+	// Wait before the first retry, doubled for each one after it
+	DefaultBackoff = 250 * time.Millisecond
 )
 
 type CensusRequester struct {
 	key string
+	// Whether to fetch the margin of error of each ACS estimate along with it
+	Margins  bool
+	Workers  int
+	Attempts int
+	Backoff  time.Duration
+	Limiter  *Limiter
 }
 
 func DefaultCensusRequester() *CensusRequester {
 	c := &CensusRequester{
-		key: secrets.Keys().CensusKey,
+		key:      secrets.Keys().CensusKey,
+		Workers:  DefaultWorkers,
+		Attempts: DefaultAttempts,
+		Backoff:  DefaultBackoff,
+		Limiter:  DefaultLimiter,
 	}
 	return c
 }
@@ -31,110 +49,216 @@ func min(a int, b int) int {
 	return b
 }
 
-func (cr CensusRequester) FetchResults(r *http.Request, url string) (string, error) {
+func max(a int, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func (cr *CensusRequester) client(r *http.Request) *api.Client {
 	c := appengine.NewContext(r)
-	client := urlfetch.Client(c)
-	resp, err := client.Get(url)
+	return api.NewClient(cr.key, urlfetch.Client(c))
+}
 
-	if err != nil {
-		return "", errors.New("response")
-		return "", err
-	}
+// Annotation the ACS gives as margin of estimates controlled to be exact
+const controlledMargin = "-555555555"
+
+/*
+ * Reasons behind the negative codes the Census Bureau publishes in place of
+ * figures. Values at or below annotationLimit that are not listed are given
+ * unknownAnnotation.
+ */
+var annotations = map[string]string{
+	"-999999999": "too few sample cases to display",
+	"-888888888": "not applicable or not available",
+	"-666666666": "too few sample observations to compute",
+	"-555555555": "controlled, no sampling error",
+	"-333333333": "median in an open-ended interval",
+	"-222222222": "too few sample observations to compute the margin",
+}
 
-	body, err := ioutil.ReadAll(resp.Body)
+const (
+	annotationLimit   = -100000000
+	unknownAnnotation = "annotated by the Census Bureau"
+	notANumber        = "not a number"
+)
+
+// Parses a figure the API gave, returning the reason it is null instead if it is an annotation
+func parseFigure(val string) (float64, string) {
+	if reason, ok := annotations[val]; ok {
+		return 0, reason
+	}
+	value, err := strconv.ParseFloat(val, 64)
 	if err != nil {
-		return "", errors.New("body")
-		return "", err
+		return 0, notANumber
 	}
-
-	return bytes.NewBuffer(body).String(), nil
+	if value <= annotationLimit {
+		return 0, unknownAnnotation
+	}
+	return value, ""
 }
 
-func (cr CensusRequester) getFieldNames(fields [][]string, fieldCodes []string) []string {
-
-	fieldNames := make([]string, len(fieldCodes))
+/*
+ * Returns the code of the margin of error of an ACS estimate, e.g.
+ * B19101_002M for B19101_002E, false for variables that are no estimates.
+ */
+func MarginCode(code string) (string, bool) {
+	if !strings.HasSuffix(code, "E") {
+		return "", false
+	}
+	return code[:len(code)-1] + "M", true
+}
 
-	for index, code := range fieldCodes {
-		for _, entry := range fields {
-			if entry[0] == code {
-				fieldNames[index] = entry[1]
-			}
+// The variables to ask the API for to fill fields
+func (cr *CensusRequester) variables(fields [][]string) []string {
+	variables := []string{}
+	for _, entry := range fields {
+		variables = append(variables, entry[0])
+		if margin, ok := MarginCode(entry[0]); ok && cr.Margins {
+			variables = append(variables, margin)
 		}
 	}
-	return fieldNames
+	return variables
 }
 
-func (cr *CensusRequester) buildSpace(spaceName string, parent map[string]interface{}) map[string]interface{} {
-	index := strings.Index(spaceName, " - ")
-	if index == -1 {
-		space := make(map[string]interface{})
-		parent[spaceName] = space
-		return space
+// Reads the estimate of code with its margin, nil if the API gave none
+func rowEstimate(row *api.Row, code string) *model.Estimate {
+	val, ok := row.Get(code)
+	if !ok {
+		return nil
 	}
-	currSpace := spaceName[:index]
-	if parent[currSpace] == nil {
-		parent[currSpace] = make(map[string]interface{})
+	value, reason := parseFigure(val)
+	estimate := &model.Estimate{Estimate: value, Null: reason}
+	margin, _ := MarginCode(code)
+	if val, ok := row.Get(margin); ok {
+		moe, reason := parseFigure(val)
+		if val == controlledMargin {
+			moe, reason = 0, ""
+		}
+		if reason == "" && moe >= 0 {
+			estimate.MOE = &moe
+		}
 	}
-	return cr.buildSpace(spaceName[index+3:], parent[currSpace].(map[string]interface{}))
+	return estimate
 }
 
-func (cr *CensusRequester) ParseResults(fields [][]string, strResults string, result map[string]interface{}) {
-	lines := strings.Split(strResults, "\n")
-
-	fieldCodes := strings.Split(lines[0][3:], "\",\"")
-	fieldNames := cr.getFieldNames(fields, fieldCodes)
-
-	fieldVals := strings.Split(lines[1][2:], "\",\"")
-	for index, name := range fieldNames {
-		if name != "" {
-			space := cr.buildSpace(name, result)
-			space["total"] = fieldVals[index]
+/*
+ * Stores the values of row under the labels fields gives their codes, as
+ * estimates with margins of error when the requester fetches them.
+ */
+func (cr *CensusRequester) fillTable(fields [][]string, row *api.Row, result *model.CensusTable) {
+	for _, entry := range fields {
+		if _, ok := MarginCode(entry[0]); ok && cr.Margins {
+			if estimate := rowEstimate(row, entry[0]); estimate != nil {
+				result.Space(entry[1]).Estimate = estimate
+			}
+		} else if val, ok := row.Get(entry[0]); ok {
+			value, reason := parseFigure(val)
+			result.Space(entry[1]).Value = &model.CensusValue{Total: value, Null: reason}
 		}
 	}
 }
 
-func (cr *CensusRequester) FormURL(r *http.Request, urlPrefix string, fields [][]string, countyCode string, stateCode string) string {
+// Responses that cannot be decoded fail the source; anything else means the API is unavailable
+func sourceError(err error) error {
+	if _, ok := err.(*api.DecodeError); ok {
+		return model.NewSourceError(model.ErrCodeFailed, err)
+	}
+	return model.NewSourceError(model.ErrCodeUnavailable, err)
+}
 
-	url := fmt.Sprintf("%vkey=%v", urlPrefix, cr.key)
-	if len(fields) > 0 {
-		fieldsStr := ""
-		for _, entry := range fields {
-			fieldsStr = fmt.Sprintf("%v,%v", fieldsStr, entry[0])
+// Whether a failed request is worth sending again: server errors, rate limiting and transport failures
+func retryable(err error) bool {
+	switch err := err.(type) {
+	case *api.Error:
+		return err.StatusCode >= 500 || err.StatusCode == http.StatusTooManyRequests
+	case *api.DecodeError:
+		return false
+	}
+	return err != api.ErrNoData && err != api.ErrNoVariables && err != api.ErrEmptyResponse
+}
+
+// Fetches variables under the rate limit, retrying with exponential backoff
+func (cr *CensusRequester) get(client *api.Client, datasetURL string, variables []string, geo *api.Geography) ([]*api.Row, error) {
+	backoff := cr.Backoff
+	for attempt := 1; ; attempt++ {
+		if cr.Limiter != nil {
+			cr.Limiter.Wait()
 		}
-		fieldsStr = fieldsStr[1:]
-		url = fmt.Sprintf("%v&get=%v", url, fieldsStr)
-	}
-	if stateCode != "" {
-		if countyCode == "" {
-			url = fmt.Sprintf("%v&for=state:%v", url, stateCode)
-		} else {
-			url = fmt.Sprintf("%v&in=state:%v", url, stateCode)
+		rows, err := client.Get(datasetURL, variables, geo)
+		if err == nil || attempt >= cr.Attempts || !retryable(err) {
+			return rows, err
 		}
+		time.Sleep(backoff)
+		backoff *= 2
 	}
-	if countyCode != "" {
-		url = fmt.Sprintf("%v&for=county:%v", url, countyCode)
-	}
-
-	return url
 }
 
-func (cr *CensusRequester) AskApiInChunks(r *http.Request, urlPrefix string, fields [][]string, countyCode string, stateCode string, maxFields int) (map[string]interface{}, error) {
-	result := make(map[string]interface{})
+// The rows of a chunk of fields, or why they could not be fetched
+type chunkResult struct {
+	fields [][]string
+	rows   []*api.Row
+	err    error
+}
 
-	for fieldStart := 0; fieldStart < len(fields); {
+/*
+ * Fetches fields, pairs of variable codes and labels, for geo from the
+ * dataset at datasetURL, asking for at most maxFields of them at a time.
+ * The chunks are fetched by up to Workers requests at once.
+ * With Margins set, each estimate comes with its margin of error and the
+ * levels no variable gives are summed from the levels below. Every figure
+ * is given its share of the level above.
+ */
+func (cr *CensusRequester) AskApiInChunks(r *http.Request, datasetURL string, fields [][]string, geo *api.Geography, maxFields int) (*model.CensusTable, error) {
+	return cr.fetchChunks(cr.client(r), datasetURL, fields, geo, maxFields)
+}
 
-		fieldEnd := min(fieldStart+maxFields, len(fields))
-		subFields := fields[fieldStart:fieldEnd]
+func (cr *CensusRequester) fetchChunks(client *api.Client, datasetURL string, fields [][]string, geo *api.Geography, maxFields int) (*model.CensusTable, error) {
+	chunks := [][][]string{}
+	for fieldStart := 0; fieldStart < len(fields); fieldStart += maxFields {
+		chunks = append(chunks, fields[fieldStart:min(fieldStart+maxFields, len(fields))])
+	}
 
-		url := cr.FormURL(r, urlPrefix, subFields, countyCode, stateCode)
+	pending := make(chan [][]string, len(chunks))
+	for _, chunk := range chunks {
+		pending <- chunk
+	}
+	close(pending)
+	results := make(chan *chunkResult, len(chunks))
+	for i := 0; i < min(max(cr.Workers, 1), len(chunks)); i++ {
+		go func() {
+			for chunk := range pending {
+				rows, err := cr.get(client, datasetURL, cr.variables(chunk), geo)
+				results <- &chunkResult{fields: chunk, rows: rows, err: err}
+			}
+		}()
+	}
 
-		censusResult, err := cr.FetchResults(r, url)
-		if err != nil {
-			return nil, err
+	result := model.NewCensusTable()
+	var firstErr error
+	for i := 0; i < len(chunks); i++ {
+		chunk := <-results
+		// areas without data answer every chunk with no content
+		if chunk.err == api.ErrNoData {
+			continue
 		}
-		cr.ParseResults(fields, censusResult, result)
-		fieldStart = fieldEnd
+		if chunk.err != nil {
+			if firstErr == nil {
+				firstErr = sourceError(chunk.err)
+			}
+			continue
+		}
+		if len(chunk.rows) > 0 {
+			cr.fillTable(chunk.fields, chunk.rows[0], result)
+		}
+	}
+	if firstErr != nil {
+		return nil, firstErr
 	}
+	if cr.Margins {
+		result.SumMissing()
+	}
+	result.ComputeShares()
 	return result, nil
-
 }
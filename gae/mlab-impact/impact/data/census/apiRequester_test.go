@@ -0,0 +1,221 @@
+package census
+
+// Unit tests for filling census tables from API rows.
+
+import (
+	"fmt"
+	"impact/data/census/api"
+	"impact/data/model"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+var testIncomeFields = [][]string{
+	{"B19101_002E", "Income - Less than $10,000"},
+	{"B19101_003E", "Income - $10,000 to $14,999"},
+	{"B19101_004E", "Income - $15,000 to $19,999"},
+	{"B19101_005E", "Income - $20,000 to $24,999"},
+}
+
+// Makes sure that margins of error are asked for along with ACS estimates only.
+func TestRequesterVariables(t *testing.T) {
+	fields := [][]string{{"B19101_002E", "Income"}, {"P0030001", "Total population"}}
+	variables := (&CensusRequester{Margins: true}).variables(fields)
+	expected := []string{"B19101_002E", "B19101_002M", "P0030001"}
+	if len(variables) != len(expected) {
+		t.Fatalf("TestRequesterVariables got %v", variables)
+	}
+	for i := range expected {
+		if variables[i] != expected[i] {
+			t.Errorf("TestRequesterVariables got %v, expected %v", variables, expected)
+		}
+	}
+	if variables := (&CensusRequester{}).variables(fields); len(variables) != 2 {
+		t.Errorf("TestRequesterVariables without margins got %v", variables)
+	}
+}
+
+// Makes sure that estimates are paired with their margins and annotations are read.
+func TestFillTableMargins(t *testing.T) {
+	row := &api.Row{Values: map[string]string{
+		"B19101_002E": "120", "B19101_002M": "15",
+		"B19101_003E": "80", "B19101_003M": "-555555555",
+		"B19101_004E": "0", "B19101_004M": "-222222222",
+		"B19101_005E": "-666666666", "B19101_005M": "-222222222",
+	}}
+	table := model.NewCensusTable()
+	(&CensusRequester{Margins: true}).fillTable(testIncomeFields, row, table)
+
+	income := table.Children["Income"].Children
+	if e := income["Less than $10,000"].Estimate; e == nil || e.Estimate != 120 || *e.MOE != 15 {
+		t.Errorf("TestFillTableMargins Less than $10,000 got %+v", e)
+	}
+	if e := income["$10,000 to $14,999"].Estimate; e == nil || e.MOE == nil || *e.MOE != 0 {
+		t.Errorf("TestFillTableMargins kept the controlled margin annotation, got %+v", e)
+	}
+	if e := income["$15,000 to $19,999"].Estimate; e == nil || e.MOE != nil {
+		t.Errorf("TestFillTableMargins gave a margin to an estimate without one, got %+v", e)
+	}
+	if e := income["$20,000 to $24,999"].Estimate; e == nil || e.Null != annotations["-666666666"] {
+		t.Errorf("TestFillTableMargins did not null an annotated estimate, got %+v", e)
+	}
+}
+
+// Makes sure that counts are parsed and annotations become null with a reason.
+func TestParseFigure(t *testing.T) {
+	tests := []struct {
+		val    string
+		value  float64
+		reason string
+	}{
+		{"267587", 267587, ""},
+		{"12.5", 12.5, ""},
+		{"-888888888", 0, "not applicable or not available"},
+		{"-123456789", 0, unknownAnnotation},
+		{"N", 0, notANumber},
+	}
+	for _, test := range tests {
+		value, reason := parseFigure(test.val)
+		if value != test.value || reason != test.reason {
+			t.Errorf("TestParseFigure %v got %v, %q", test.val, value, reason)
+		}
+	}
+}
+
+// Makes sure that chunks are fetched in parallel and failed ones are retried.
+func TestFetchChunks(t *testing.T) {
+	var mutex sync.Mutex
+	requests, failed := 0, false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		requests++
+		failFirst := !failed && strings.Contains(r.FormValue("get"), "P0030003")
+		failed = failed || failFirst
+		mutex.Unlock()
+		if failFirst {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		variables := strings.Split(r.FormValue("get"), ",")
+		values := make([]string, len(variables))
+		for i, variable := range variables {
+			values[i] = fmt.Sprintf("%q", variable[len(variable)-1:])
+		}
+		fmt.Fprintf(w, `[["%v","state"],[%v,"37"]]`, strings.Join(variables, `","`), strings.Join(values, ","))
+	}))
+	defer server.Close()
+
+	fields := [][]string{
+		{"P0030001", "Total"},
+		{"P0030002", "Total - White alone"},
+		{"P0030003", "Total - Black or African American alone"},
+		{"P0030004", "Total - American Indian and Alaska Native alone"},
+		{"P0030005", "Total - Asian alone"},
+	}
+	requester := &CensusRequester{Workers: 3, Attempts: 2}
+	table, err := requester.fetchChunks(api.NewClient("", http.DefaultClient), server.URL, fields, api.ForState("37"), 2)
+	if err != nil {
+		t.Fatalf("TestFetchChunks:fetchChunks err = %v", err)
+	}
+	if requests != 4 {
+		t.Errorf("TestFetchChunks sent %v requests for 3 chunks and a retry", requests)
+	}
+	total := table.Children["Total"]
+	if total.Value.Total != 1 || total.Children["Black or African American alone"].Value.Total != 3 ||
+		total.Children["Asian alone"].Value.Total != 5 {
+		t.Errorf("TestFetchChunks got %+v", total)
+	}
+
+	requester.Attempts = 1
+	failed = false
+	if _, err := requester.fetchChunks(api.NewClient("", http.DefaultClient), server.URL, fields, api.ForState("37"), 2); err == nil {
+		t.Errorf("TestFetchChunks ignored a failed chunk")
+	}
+}
+
+// Makes sure that only transient failures are retried.
+func TestRetryable(t *testing.T) {
+	if !retryable(&api.Error{StatusCode: http.StatusServiceUnavailable}) || !retryable(&api.Error{StatusCode: 429}) {
+		t.Errorf("TestRetryable gave up on a transient failure")
+	}
+	if retryable(&api.Error{StatusCode: http.StatusBadRequest}) || retryable(&api.DecodeError{}) || retryable(api.ErrNoData) {
+		t.Errorf("TestRetryable retried a permanent failure")
+	}
+}
+
+/*
+ * Serves every estimate of a dataset as 10 with a margin of 2, except those
+ * given in figures, as "code": "estimate,margin".
+ */
+func testEstimateServer(figures map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		variables := strings.Split(r.FormValue("get"), ",")
+		values := make([]string, len(variables))
+		for i, variable := range variables {
+			estimate, margin := "10", "2"
+			code := variable[:len(variable)-1] + "E"
+			if figure, ok := figures[code]; ok {
+				parts := strings.Split(figure, ",")
+				estimate, margin = parts[0], parts[1]
+			}
+			if strings.HasSuffix(variable, "M") {
+				values[i] = fmt.Sprintf("%q", margin)
+			} else {
+				values[i] = fmt.Sprintf("%q", estimate)
+			}
+		}
+		fmt.Fprintf(w, `[["%v","state"],[%v,"37"]]`, strings.Join(variables, `","`), strings.Join(values, ","))
+	}))
+}
+
+// Fetches the deployed citizenship topic, whose Total, Male and Female are siblings.
+func testCitizenshipTable(t *testing.T) *model.CensusTable {
+	catalog, err := ReadVariables("../../../" + VariablesFile)
+	if err != nil {
+		t.Fatalf("testCitizenshipTable:ReadVariables err = %v", err)
+	}
+	server := testEstimateServer(map[string]string{
+		"B05003F_001E": "100,10",
+		"B05003F_002E": "25,6",
+		"B05003F_003E": "5,3",
+		"B05003F_013E": "75,8",
+	})
+	defer server.Close()
+
+	requester := &CensusRequester{Margins: true, Workers: 2, Attempts: 1}
+	fields := catalog.Fields("acs", []string{"citizenship"})
+	table, err := requester.fetchChunks(api.NewClient("", http.DefaultClient), server.URL, fields, api.ForState("37"), 5)
+	if err != nil {
+		t.Fatalf("testCitizenshipTable:fetchChunks err = %v", err)
+	}
+	return table.Children["Sex by Age by Citizenship Status"]
+}
+
+// Makes sure that a level takes the estimate of its Total rather than adding Total, Male and Female up.
+func TestCitizenshipTotal(t *testing.T) {
+	citizenship := testCitizenshipTable(t)
+	if citizenship == nil || citizenship.Estimate == nil {
+		t.Fatalf("TestCitizenshipTotal got no citizenship estimate")
+	}
+	if citizenship.Estimate.Estimate != 100 || citizenship.Estimate.MOE == nil || *citizenship.Estimate.MOE != 10 {
+		t.Errorf("TestCitizenshipTotal got %+v, margin %v", citizenship.Estimate, citizenship.Estimate.MOE)
+	}
+}
+
+// Makes sure that shares in the deployed citizenship topic are taken of its Total.
+func TestCitizenshipShares(t *testing.T) {
+	citizenship := testCitizenshipTable(t)
+	male, female := citizenship.Children["Male"], citizenship.Children["Female"]
+	if male.Share == nil || *male.Share != 25 || female.Share == nil || *female.Share != 75 {
+		t.Errorf("TestCitizenshipShares got Male %v, Female %v", male.Share, female.Share)
+	}
+	if share := citizenship.Children["Total"].Share; share != nil {
+		t.Errorf("TestCitizenshipShares gave the total a share of itself, %v", *share)
+	}
+	if share := male.Children["Under 18 years"].Share; share == nil || *share != 20 {
+		t.Errorf("TestCitizenshipShares Male - Under 18 years got %v", share)
+	}
+}
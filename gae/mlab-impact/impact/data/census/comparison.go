@@ -0,0 +1,53 @@
+package census
+
+import (
+	"impact/data/model"
+)
+
+// Fetches the figures of a location, with the census geography they cover
+type LocationFetcher func(loc *model.Location) (*model.CensusTable, string, error)
+
+func asSourceError(err error) *model.SourceError {
+	if sourceErr, ok := err.(*model.SourceError); ok {
+		return sourceErr
+	}
+	return model.NewSourceError(model.ErrCodeFailed, err)
+}
+
+/*
+ * Fills the client and server sides of comparison, fetching the figures of
+ * both locations at the same time. A side that fails is left without a
+ * table and has its error recorded on comparison, so that the other side
+ * is still reported. Fails with the client's error only if both fail.
+ */
+func Compare(comparison *model.CensusComparison, clientLoc *model.Location, serverLoc *model.Location, fetch LocationFetcher) error {
+	type side struct {
+		table *model.CensusTable
+		level string
+		err   error
+	}
+	serverDone := make(chan *side, 1)
+	go func() {
+		table, level, err := fetch(serverLoc)
+		serverDone <- &side{table, level, err}
+	}()
+
+	clientTable, clientLevel, clientErr := fetch(clientLoc)
+	server := <-serverDone
+	if clientErr != nil && server.err != nil {
+		return clientErr
+	}
+	if clientErr != nil {
+		comparison.ClientError = asSourceError(clientErr)
+	} else {
+		comparison.Client = clientTable
+		comparison.ClientGeography = clientLevel
+	}
+	if server.err != nil {
+		comparison.ServerError = asSourceError(server.err)
+	} else {
+		comparison.Server = server.table
+		comparison.ServerGeography = server.level
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package census
+
+// Unit tests for comparing the census figures of two locations.
+
+import (
+	"errors"
+	"impact/data/model"
+	"testing"
+)
+
+// Makes sure that each side gets the figures of its own location.
+func TestCompare(t *testing.T) {
+	client := &model.Location{Zip: "27701"}
+	server := &model.Location{Region: "Georgia"}
+	comparison := &model.CensusComparison{}
+	err := Compare(comparison, client, server, func(loc *model.Location) (*model.CensusTable, string, error) {
+		table := model.NewCensusTable()
+		if loc == client {
+			return table, ZCTAGeography, nil
+		}
+		return table, StateGeography, nil
+	})
+	if err != nil || comparison.ClientGeography != ZCTAGeography || comparison.ServerGeography != StateGeography {
+		t.Errorf("TestCompare got %+v, %v", comparison, err)
+	}
+
+}
+
+// Makes sure that a failing side is reported without losing the other, and only both failing fails.
+func TestCompareOneSideFails(t *testing.T) {
+	client := &model.Location{Zip: "27701"}
+	server := &model.Location{Region: "Georgia"}
+	failure := model.NewSourceError(model.ErrCodeUnavailable, errors.New("census api: 503 Service Unavailable"))
+	clientTable := model.NewCensusTable()
+
+	comparison := &model.CensusComparison{}
+	err := Compare(comparison, client, server, func(loc *model.Location) (*model.CensusTable, string, error) {
+		if loc == server {
+			return nil, "", failure
+		}
+		return clientTable, CountyGeography, nil
+	})
+	if err != nil || comparison.Client != clientTable || comparison.ClientGeography != CountyGeography {
+		t.Errorf("TestCompareOneSideFails lost the client side: %+v, %v", comparison, err)
+	}
+	if comparison.Server != nil || comparison.ServerError != failure || comparison.Complete() {
+		t.Errorf("TestCompareOneSideFails did not report the server side: %+v", comparison)
+	}
+
+	comparison = &model.CensusComparison{}
+	err = Compare(comparison, client, server, func(loc *model.Location) (*model.CensusTable, string, error) {
+		if loc == client {
+			return nil, "", errors.New("no geography")
+		}
+		return model.NewCensusTable(), StateGeography, nil
+	})
+	if err != nil || comparison.Server == nil || comparison.ClientError == nil || comparison.ClientError.Code != model.ErrCodeFailed {
+		t.Errorf("TestCompareOneSideFails did not report the client side: %+v, %v", comparison, err)
+	}
+
+	err = Compare(&model.CensusComparison{}, client, server, func(loc *model.Location) (*model.CensusTable, string, error) {
+		if loc == client {
+			return nil, "", failure
+		}
+		return nil, "", errors.New("server side")
+	})
+	if err != failure {
+		t.Errorf("TestCompareOneSideFails got %v with both sides failing", err)
+	}
+}
@@ -0,0 +1,54 @@
+package census
+
+import (
+	"impact/data/census/api"
+	"net/http"
+	"sort"
+)
+
+// A figure of one county, null with a reason when the Census Bureau published an annotation
+type CountyFigure struct {
+	County *County
+	Value  float64
+	Null   string
+}
+
+/*
+ * Fetches a variable for every county of the state with the given FIPS code
+ * in one request, ordered by county code. Counties the gazetteer does not
+ * know are left out.
+ */
+func (cr *CensusRequester) AskCounties(r *http.Request, datasetURL string, variable string, stateCode string) ([]*CountyFigure, error) {
+	return cr.fetchCounties(cr.client(r), datasetURL, variable, stateCode)
+}
+
+func (cr *CensusRequester) fetchCounties(client *api.Client, datasetURL string, variable string, stateCode string) ([]*CountyFigure, error) {
+	rows, err := cr.get(client, datasetURL, []string{variable}, api.ForCounty(stateCode, api.Wildcard))
+	if err == api.ErrNoData {
+		return []*CountyFigure{}, nil
+	}
+	if err != nil {
+		return nil, sourceError(err)
+	}
+
+	figures := []*CountyFigure{}
+	for _, row := range rows {
+		county, ok := DefaultGazetteer.CountyByCode(stateCode, row.Geography[api.County])
+		if !ok {
+			continue
+		}
+		figure := &CountyFigure{County: county, Null: "not published"}
+		if val, ok := row.Get(variable); ok {
+			figure.Value, figure.Null = parseFigure(val)
+		}
+		figures = append(figures, figure)
+	}
+	sort.Sort(byCountyCode(figures))
+	return figures, nil
+}
+
+type byCountyCode []*CountyFigure
+
+func (b byCountyCode) Len() int           { return len(b) }
+func (b byCountyCode) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
+func (b byCountyCode) Less(i, j int) bool { return b[i].County.Code < b[j].County.Code }
@@ -0,0 +1,41 @@
+package census
+
+// Unit tests for fetching a variable for every county of a state.
+
+import (
+	"fmt"
+	"impact/data/census/api"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// Makes sure that counties are named through the gazetteer and annotations become null.
+func TestFetchCounties(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("for") != "county:*" || r.FormValue("in") != "state:37" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fmt.Fprint(w, `[["B19013_001E","state","county"],
+			["-666666666","37","065"],
+			["46000","37","063"],
+			["1","37","999"]]`)
+	}))
+	defer server.Close()
+
+	requester := &CensusRequester{Attempts: 1}
+	figures, err := requester.fetchCounties(api.NewClient("", http.DefaultClient), server.URL, "B19013_001E", "37")
+	if err != nil {
+		t.Fatalf("TestFetchCounties:fetchCounties err = %v", err)
+	}
+	if len(figures) != 2 {
+		t.Fatalf("TestFetchCounties got %v counties", len(figures))
+	}
+	if figures[0].County.Name != "Durham" || figures[0].Value != 46000 || figures[0].Null != "" {
+		t.Errorf("TestFetchCounties first county got %+v", figures[0])
+	}
+	if figures[1].County.Code != "065" || figures[1].Null == "" {
+		t.Errorf("TestFetchCounties kept an annotation as a number, got %+v", figures[1])
+	}
+}
@@ -0,0 +1,224 @@
+package census
+
+import (
+	"appengine"
+	"appengine/datastore"
+
+	"errors"
+	"fmt"
+	"impact/data/census/api"
+	"impact/data/model"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const datasetSettingKind = "CensusDataset"
+
+var errUnknownCatalog = errors.New("No census datasets are registered for this source")
+
+/*
+ * A census data product of one vintage, e.g. the 2010 ACS 5-year estimates,
+ * with the name of the set of variables it is queried for. Datasets of
+ * different vintages sharing labels can be compared over time. Margins is
+ * set for sample based products, whose estimates have margins of error.
+ */
+type Dataset struct {
+	Vintage   string `json:"vintage"`
+	Product   string `json:"product"`
+	Variables string `json:"variables"`
+	Margins   bool   `json:"margins,omitempty"`
+}
+
+// Names the dataset as requests do, e.g. 2010/acs5
+func (d *Dataset) Key() string {
+	return d.Vintage + "/" + d.Product
+}
+
+func (d *Dataset) URL() string {
+	return api.DatasetURL(d.Vintage, d.Product)
+}
+
+/*
+ * The datasets a census source can query. Requests pick one with Parameter,
+ * e.g. acsDataset=2012/acs1; otherwise the deployment's choice, stored in
+ * the datastore, or Default is used. VariablesParameter lists variables to
+ * fetch instead of the dataset's topics, e.g. acsVariables=B19013_001E.
+ */
+type Catalog struct {
+	Source             string
+	Parameter          string
+	VariablesParameter string
+	Default            string
+	datasets           map[string]*Dataset
+	keys               []string
+}
+
+// Creates a catalog whose request parameters start with prefix, e.g. acs for acsDataset
+func NewCatalog(source string, prefix string, defaultKey string, datasets ...*Dataset) *Catalog {
+	c := &Catalog{
+		Source:             source,
+		Parameter:          prefix + "Dataset",
+		VariablesParameter: prefix + "Variables",
+		Default:            defaultKey,
+		datasets:           make(map[string]*Dataset),
+	}
+	for _, dataset := range datasets {
+		c.datasets[dataset.Key()] = dataset
+		c.keys = append(c.keys, dataset.Key())
+	}
+	if c.datasets[defaultKey] == nil {
+		panic(fmt.Sprintf("census: default dataset %v of %v is not in its catalog", defaultKey, source))
+	}
+	return c
+}
+
+func (c *Catalog) Lookup(key string) (*Dataset, bool) {
+	dataset, ok := c.datasets[strings.ToLower(strings.TrimSpace(key))]
+	return dataset, ok
+}
+
+// Datasets in the order they were given to NewCatalog
+func (c *Catalog) Datasets() []*Dataset {
+	datasets := make([]*Dataset, len(c.keys))
+	for i, key := range c.keys {
+		datasets[i] = c.datasets[key]
+	}
+	return datasets
+}
+
+// Picks the requested dataset, else the deployment's, else the default
+func (c *Catalog) choose(requested string, deployment string) (*Dataset, error) {
+	if requested != "" {
+		dataset, ok := c.Lookup(requested)
+		if !ok {
+			return nil, fmt.Errorf("Unknown %v dataset %q, expected one of %v", c.Source, requested, strings.Join(c.keys, ", "))
+		}
+		return dataset, nil
+	}
+	if dataset, ok := c.Lookup(deployment); ok {
+		return dataset, nil
+	}
+	return c.datasets[c.Default], nil
+}
+
+// Returns the dataset a request asks for, failing with ErrCodeInvalidRequest for unknown ones
+func (c *Catalog) Select(r *http.Request) (*Dataset, error) {
+	requested := r.FormValue(c.Parameter)
+	if requested != "" {
+		dataset, err := c.choose(requested, "")
+		if err != nil {
+			return nil, model.NewSourceError(model.ErrCodeInvalidRequest, err)
+		}
+		return dataset, nil
+	}
+	deployment, err := deploymentDataset(r, c.Source)
+	if err != nil {
+		return nil, model.NewSourceError(model.ErrCodeUnavailable, err)
+	}
+	return c.choose("", deployment)
+}
+
+var (
+	catalogMutex sync.Mutex
+	catalogs     = make(map[string]*Catalog)
+)
+
+// Makes a catalog known to the admin endpoints; census sources call it from init
+func RegisterCatalog(c *Catalog) {
+	catalogMutex.Lock()
+	defer catalogMutex.Unlock()
+	catalogs[strings.ToLower(c.Source)] = c
+}
+
+func LookupCatalog(source string) (*Catalog, bool) {
+	catalogMutex.Lock()
+	defer catalogMutex.Unlock()
+	c, ok := catalogs[strings.ToLower(strings.TrimSpace(source))]
+	return c, ok
+}
+
+// Every registered catalog, by source name
+func Catalogs() []*Catalog {
+	catalogMutex.Lock()
+	defer catalogMutex.Unlock()
+	names := []string{}
+	for name := range catalogs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	result := make([]*Catalog, len(names))
+	for i, name := range names {
+		result[i] = catalogs[name]
+	}
+	return result
+}
+
+// Datastore entity recording the dataset a deployment queries for a source
+type DatasetSetting struct {
+	Source  string
+	Dataset string
+}
+
+// Returns the dataset key stored for source, or "" if there is none
+func deploymentDataset(r *http.Request, source string) (string, error) {
+	c := appengine.NewContext(r)
+	key := datastore.NewKey(c, datasetSettingKind, strings.ToLower(source), 0, nil)
+	setting := &DatasetSetting{}
+	err := datastore.Get(c, key, setting)
+	if err == datastore.ErrNoSuchEntity {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return setting.Dataset, nil
+}
+
+// Changes the dataset a source queries when requests do not pick one
+func SetDeploymentDataset(r *http.Request, source string, datasetKey string) error {
+	catalog, ok := LookupCatalog(source)
+	if !ok {
+		return errUnknownCatalog
+	}
+	dataset, err := catalog.choose(datasetKey, "")
+	if err != nil {
+		return err
+	}
+
+	c := appengine.NewContext(r)
+	key := datastore.NewKey(c, datasetSettingKind, strings.ToLower(catalog.Source), 0, nil)
+	_, err = datastore.Put(c, key, &DatasetSetting{Source: catalog.Source, Dataset: dataset.Key()})
+	return err
+}
+
+// What the admin endpoints report about a catalog
+type CatalogInfo struct {
+	Source             string     `json:"source"`
+	Parameter          string     `json:"parameter"`
+	VariablesParameter string     `json:"variablesParameter"`
+	Default            string     `json:"default"`
+	Deployment         string     `json:"deployment,omitempty"`
+	Datasets           []*Dataset `json:"datasets"`
+}
+
+// Lists the registered catalogs with the dataset each deployment queries
+func DescribeCatalogs(r *http.Request) ([]*CatalogInfo, error) {
+	infos := []*CatalogInfo{}
+	for _, catalog := range Catalogs() {
+		deployment, err := deploymentDataset(r, catalog.Source)
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, &CatalogInfo{
+			Source:             catalog.Source,
+			Parameter:          catalog.Parameter,
+			VariablesParameter: catalog.VariablesParameter,
+			Default:            catalog.Default,
+			Deployment:         deployment,
+			Datasets:           catalog.Datasets(),
+		})
+	}
+	return infos, nil
+}
@@ -0,0 +1,58 @@
+package census
+
+// Unit tests for picking the census dataset of a query.
+
+import (
+	"impact/data/model"
+	"net/http"
+	"testing"
+)
+
+var testCatalog = NewCatalog("Test", "test", "2010/acs5",
+	&Dataset{Vintage: "2010", Product: "acs5", Variables: "acs-2010"},
+	&Dataset{Vintage: "2012", Product: "acs1", Variables: "acs-2010"},
+)
+
+// Makes sure that requests win over the deployment, which wins over the default.
+func TestCatalogChoose(t *testing.T) {
+	tests := []struct {
+		requested  string
+		deployment string
+		expected   string
+	}{
+		{"", "", "2010/acs5"},
+		{"", "2012/acs1", "2012/acs1"},
+		{" 2012/ACS1 ", "", "2012/acs1"},
+		{"2010/acs5", "2012/acs1", "2010/acs5"},
+		// a deployment setting that was dropped from the catalog
+		{"", "2009/acs5", "2010/acs5"},
+	}
+	for _, test := range tests {
+		dataset, err := testCatalog.choose(test.requested, test.deployment)
+		if err != nil || dataset.Key() != test.expected {
+			t.Errorf("TestCatalogChoose %q, %q got %v, %v", test.requested, test.deployment, dataset, err)
+		}
+	}
+
+	if _, err := testCatalog.choose("2010/sf1", ""); err == nil {
+		t.Errorf("TestCatalogChoose accepted a dataset outside the catalog")
+	}
+}
+
+// Makes sure that datasets build the API URL of their vintage and product.
+func TestDatasetURL(t *testing.T) {
+	dataset, _ := testCatalog.Lookup("2012/acs1")
+	if url := dataset.URL(); url != "http://api.census.gov/data/2012/acs1" {
+		t.Errorf("TestDatasetURL got %v", url)
+	}
+}
+
+// Makes sure that custom variables labeled like a figure are refused as invalid requests.
+func TestCatalogFieldsReservedLabel(t *testing.T) {
+	dataset, _ := testCatalog.Lookup("2010/acs5")
+	r, _ := http.NewRequest("GET", "/query?testVariables=B19013_001E:moe", nil)
+	_, err := testCatalog.Fields(r, dataset)
+	if sourceErr, ok := err.(*model.SourceError); !ok || sourceErr.Code != model.ErrCodeInvalidRequest {
+		t.Errorf("TestCatalogFieldsReservedLabel got %v", err)
+	}
+}
@@ -0,0 +1,206 @@
+package census
+
+import (
+	"strings"
+)
+
+type State struct {
+	Code         string
+	Abbreviation string
+	Name         string
+}
+
+type County struct {
+	StateCode string
+	Code      string
+	Name      string
+}
+
+// Suffixes dropped from county names, longest first so that "city and borough" wins over "borough"
+var countySuffixes = []string{
+	" city and borough",
+	" census area",
+	" municipality",
+	" municipio",
+	" borough",
+	" county",
+	" parish",
+}
+
+var nameReplacer = strings.NewReplacer(
+	".", "",
+	"'", "",
+	",", "",
+	"-", " ",
+	"&", " and ",
+	"á", "a", "é", "e", "í", "i", "ó", "o", "ú", "u", "ü", "u", "ñ", "n",
+)
+
+/*
+ * Reduces a state or county name to the form names are matched on: lower
+ * case, without punctuation or accents, "Saint" shortened to "st" and
+ * without a trailing "County", "Parish", "Census Area" or the like.
+ * Independent cities keep their "city", as e.g. Virginia has both a
+ * Richmond County and a Richmond city.
+ */
+func NormalizeName(name string) string {
+	name = nameReplacer.Replace(strings.ToLower(name))
+	words := strings.Fields(name)
+	for i, word := range words {
+		if word == "saint" {
+			words[i] = "st"
+		}
+	}
+	name = strings.Join(words, " ")
+	for _, suffix := range countySuffixes {
+		if strings.HasSuffix(name, suffix) && len(name) > len(suffix) {
+			return name[:len(name)-len(suffix)]
+		}
+	}
+	return name
+}
+
+// Levenshtein distance between two strings
+func editDistance(a string, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(min(previous[j]+1, current[j-1]+1), previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+// Most typos a name of this length may have and still match
+func maxDistance(name string) int {
+	return len(name) / 5
+}
+
+/*
+ * Picks the only candidate within maxDistance of name, preferring the
+ * closest. Reports false when there is none or two are equally close.
+ */
+func fuzzyMatch(name string, candidates []string) (string, bool) {
+	best, bestDistance, tied := "", maxDistance(name)+1, false
+	for _, candidate := range candidates {
+		distance := editDistance(name, candidate)
+		if distance < bestDistance {
+			best, bestDistance, tied = candidate, distance, false
+		} else if distance == bestDistance {
+			tied = true
+		}
+	}
+	return best, best != "" && !tied
+}
+
+/*
+ * FIPS codes of the states and counties, looked up by name in either
+ * direction. The data in gazetteer_data.go is written by gengazetteer from
+ * the Census Bureau's code files; see gengazetteer/main.go to regenerate it.
+ */
+type Gazetteer struct {
+	// states by code, abbreviation and normalized name
+	states     map[string]*State
+	stateNames []string
+	// counties by state code, then by code and by normalized name
+	countyCodes map[string]map[string]*County
+	counties    map[string]map[string]*County
+	countyNames map[string][]string
+}
+
+func NewGazetteer(states []State, counties []County) *Gazetteer {
+	g := &Gazetteer{
+		states:      make(map[string]*State),
+		countyCodes: make(map[string]map[string]*County),
+		counties:    make(map[string]map[string]*County),
+		countyNames: make(map[string][]string),
+	}
+	for i := range states {
+		state := &states[i]
+		name := NormalizeName(state.Name)
+		g.states[state.Code] = state
+		g.states[strings.ToLower(state.Abbreviation)] = state
+		g.states[name] = state
+		g.stateNames = append(g.stateNames, name)
+		g.countyCodes[state.Code] = make(map[string]*County)
+		g.counties[state.Code] = make(map[string]*County)
+	}
+	for i := range counties {
+		county := &counties[i]
+		name := NormalizeName(county.Name)
+		// counties of areas missing from states are still looked up by state code
+		if g.countyCodes[county.StateCode] == nil {
+			g.countyCodes[county.StateCode] = make(map[string]*County)
+			g.counties[county.StateCode] = make(map[string]*County)
+		}
+		g.countyCodes[county.StateCode][county.Code] = county
+		g.counties[county.StateCode][name] = county
+		g.countyNames[county.StateCode] = append(g.countyNames[county.StateCode], name)
+	}
+	return g
+}
+
+var DefaultGazetteer = NewGazetteer(gazetteerStates, gazetteerCounties)
+
+// Looks a state up by name, postal abbreviation or FIPS code
+func (g *Gazetteer) State(name string) (*State, bool) {
+	key := NormalizeName(name)
+	if state, ok := g.states[key]; ok {
+		return state, true
+	}
+	if match, ok := fuzzyMatch(key, g.stateNames); ok {
+		return g.states[match], true
+	}
+	return nil, false
+}
+
+// Looks a county of the state with the given FIPS code up by name
+func (g *Gazetteer) County(stateCode string, name string) (*County, bool) {
+	key := NormalizeName(name)
+	if county, ok := g.counties[stateCode][key]; ok {
+		return county, true
+	}
+	if match, ok := fuzzyMatch(key, g.countyNames[stateCode]); ok {
+		return g.counties[stateCode][match], true
+	}
+	return nil, false
+}
+
+// Looks a state up by its FIPS code
+func (g *Gazetteer) StateByCode(code string) (*State, bool) {
+	state, ok := g.states[code]
+	return state, ok && state.Code == code
+}
+
+// Looks a county up by the FIPS codes of its state and itself
+func (g *Gazetteer) CountyByCode(stateCode string, code string) (*County, bool) {
+	county, ok := g.countyCodes[stateCode][code]
+	return county, ok
+}
+
+/*
+ * Returns the FIPS codes of a county and its state, given their names. The
+ * county code is empty when only the state is known, and both are empty for
+ * places outside the states.
+ */
+func CountyAndStateCodes(county string, state string) (string, string) {
+	stateEntry, ok := DefaultGazetteer.State(state)
+	if !ok {
+		return "", ""
+	}
+	countyEntry, ok := DefaultGazetteer.County(stateEntry.Code, county)
+	if !ok {
+		return "", stateEntry.Code
+	}
+	return countyEntry.Code, stateEntry.Code
+}
@@ -0,0 +1,84 @@
+package census
+
+// Unit tests for the FIPS gazetteer.
+
+import (
+	"testing"
+)
+
+// Makes sure that names match whatever suffix or spelling they come with.
+func TestNormalizeName(t *testing.T) {
+	for name, expected := range map[string]string{
+		"King County":                "king",
+		"Aleutians West Census Area": "aleutians west",
+		"Juneau City and Borough":    "juneau",
+		"St. Louis city":             "st louis city",
+		"Saint Mary's Parish":        "st marys",
+		"Doña Ana":                   "dona ana",
+		"County":                     "county",
+	} {
+		if got := NormalizeName(name); got != expected {
+			t.Errorf("TestNormalizeName %q = %q, expected %q", name, got, expected)
+		}
+	}
+}
+
+// Makes sure that counties are found by their common names and misspellings.
+func TestCountyAndStateCodes(t *testing.T) {
+	tests := []struct {
+		county, state         string
+		countyCode, stateCode string
+	}{
+		{"Durham", "North Carolina", "063", "37"},
+		{"Durham County", "NC", "063", "37"},
+		{"King County", "washington", "033", "53"},
+		{"Anchorage", "Alaska", "020", "02"},
+		{"Richmond County", "Virginia", "159", "51"},
+		{"Richmond city", "Virginia", "760", "51"},
+		{"Mecklenberg County", "North Carolina", "119", "37"},
+		{"Nowhere", "North Carolina", "", "37"},
+		{"Durham", "Ontario", "", ""},
+	}
+	for _, test := range tests {
+		countyCode, stateCode := CountyAndStateCodes(test.county, test.state)
+		if countyCode != test.countyCode || stateCode != test.stateCode {
+			t.Errorf("TestCountyAndStateCodes %v, %v = %v, %v", test.county, test.state, countyCode, stateCode)
+		}
+	}
+}
+
+// Makes sure that codes lead back to names.
+func TestReverseLookup(t *testing.T) {
+	state, ok := DefaultGazetteer.StateByCode("37")
+	if !ok || state.Name != "North Carolina" || state.Abbreviation != "NC" {
+		t.Errorf("TestReverseLookup state = %+v", state)
+	}
+	county, ok := DefaultGazetteer.CountyByCode("37", "063")
+	if !ok || county.Name != "Durham" {
+		t.Errorf("TestReverseLookup county = %+v", county)
+	}
+	if _, ok := DefaultGazetteer.StateByCode("nc"); ok {
+		t.Errorf("TestReverseLookup took an abbreviation for a code")
+	}
+}
+
+// Makes sure that equally close names are not guessed between.
+func TestFuzzyMatchTie(t *testing.T) {
+	if match, ok := fuzzyMatch("abcdefghij", []string{"abcdefghix", "abcdefghiy"}); ok {
+		t.Errorf("TestFuzzyMatchTie picked %v", match)
+	}
+	if match, ok := fuzzyMatch("abcdefghij", []string{"abcdefghix", "zzz"}); !ok || match != "abcdefghix" {
+		t.Errorf("TestFuzzyMatchTie missed %v", match)
+	}
+}
+
+// Makes sure that counties of a state the gazetteer was not given are still kept.
+func TestGazetteerCountyWithoutState(t *testing.T) {
+	g := NewGazetteer([]State{{"37", "NC", "North Carolina"}}, []County{
+		{"37", "063", "Durham"},
+		{"72", "127", "San Juan Municipio"},
+	})
+	if county, ok := g.CountyByCode("72", "127"); !ok || county.Name != "San Juan Municipio" {
+		t.Errorf("TestGazetteerCountyWithoutState got %v, %v", county, ok)
+	}
+}
@@ -0,0 +1,79 @@
+/*
+ * Command gengazetteer writes the FIPS gazetteer of the census package from
+ * the Census Bureau's state and county code files:
+ *
+ *	http://www.census.gov/geo/reference/docs/state.txt
+ *	http://www.census.gov/geo/reference/codes/files/national_county.txt
+ *
+ * Run it from the census package directory:
+ *
+ *	go run gengazetteer/main.go -states state.txt -counties national_county.txt > gazetteer_data.go
+ */
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+)
+
+var (
+	statesFile   = flag.String("states", "state.txt", "pipe separated STATE|STUSAB|STATE_NAME|STATENS file")
+	countiesFile = flag.String("counties", "national_county.txt", "comma separated STATE,STATEFP,COUNTYFP,COUNTYNAME,CLASSFP file")
+)
+
+func readRecords(name string, separator rune, fields int) [][]string {
+	file, err := os.Open(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(bufio.NewReader(file))
+	reader.Comma = separator
+	reader.FieldsPerRecord = fields
+	records := [][]string{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatalf("%v: %v", name, err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func main() {
+	flag.Parse()
+	states := readRecords(*statesFile, '|', 4)
+	counties := readRecords(*countiesFile, ',', 5)
+
+	fmt.Println("// FIPS codes of the states and counties, written by gengazetteer from the")
+	fmt.Println("// Census Bureau code files. Regenerate rather than editing by hand.")
+	fmt.Println()
+	fmt.Println("package census")
+	fmt.Println()
+	fmt.Println("var gazetteerStates = []State{")
+	for _, record := range states {
+		// skip the header row
+		if record[0] == "STATE" {
+			continue
+		}
+		fmt.Printf("\t{%q, %q, %q},\n", record[0], record[1], strings.TrimSpace(record[2]))
+	}
+	fmt.Println("}")
+	fmt.Println()
+	fmt.Println("var gazetteerCounties = []County{")
+	for _, record := range counties {
+		fmt.Printf("\t{%q, %q, %q},\n", record[1], record[2], strings.TrimSpace(record[3]))
+	}
+	fmt.Println("}")
+}
@@ -0,0 +1,115 @@
+package census
+
+import (
+	"appengine"
+	"appengine/urlfetch"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"impact/data/census/api"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+// The Census Bureau geocoder, which finds the census areas around a point
+const GeocoderURL = "http://geocoding.geo.census.gov/geocoder/geographies/coordinates"
+
+var errNoTract = errors.New("census geocoder: no census tract at these coordinates")
+
+// FIPS codes of the census areas containing a point
+type Codes struct {
+	State      string
+	County     string
+	Tract      string
+	BlockGroup string
+	Place      string
+}
+
+// Returns the geography of the area of the given level containing the point
+func (codes *Codes) geography(level string) (*api.Geography, bool) {
+	switch level {
+	case BlockGroupGeography:
+		if codes.BlockGroup != "" {
+			return api.ForBlockGroup(codes.State, codes.County, codes.Tract, codes.BlockGroup), true
+		}
+	case TractGeography:
+		return api.ForTract(codes.State, codes.County, codes.Tract), true
+	case PlaceGeography:
+		if codes.Place != "" {
+			return api.ForPlace(codes.State, codes.Place), true
+		}
+	}
+	return nil, false
+}
+
+/*
+ * Looks up the census areas containing a point with the Census geocoder.
+ * Lookups share DefaultLimiter with the Census API requests.
+ */
+func Locate(r *http.Request, lat float64, lng float64) (*Codes, error) {
+	values := url.Values{}
+	values.Set("x", fmt.Sprintf("%v", lng))
+	values.Set("y", fmt.Sprintf("%v", lat))
+	values.Set("benchmark", "Public_AR_Census2010")
+	values.Set("vintage", "Census2010_Census2010")
+	values.Set("format", "json")
+
+	DefaultLimiter.Wait()
+	c := appengine.NewContext(r)
+	resp, err := urlfetch.Client(c).Get(GeocoderURL + "?" + values.Encode())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("census geocoder: %v", resp.Status)
+	}
+	return parseGeocoderResponse(body)
+}
+
+// The layers of a geocoder response, each a list of areas
+type geocoderResponse struct {
+	Result struct {
+		Geographies map[string][]map[string]interface{} `json:"geographies"`
+	} `json:"result"`
+}
+
+func firstArea(geographies map[string][]map[string]interface{}, layer string) map[string]interface{} {
+	if areas := geographies[layer]; len(areas) > 0 {
+		return areas[0]
+	}
+	return map[string]interface{}{}
+}
+
+func attribute(area map[string]interface{}, name string) string {
+	if val, ok := area[name].(string); ok {
+		return val
+	}
+	return ""
+}
+
+func parseGeocoderResponse(body []byte) (*Codes, error) {
+	response := &geocoderResponse{}
+	if err := json.Unmarshal(body, response); err != nil {
+		return nil, fmt.Errorf("census geocoder: %v", err)
+	}
+	geographies := response.Result.Geographies
+
+	tract := firstArea(geographies, "Census Tracts")
+	codes := &Codes{
+		State:      attribute(tract, "STATE"),
+		County:     attribute(tract, "COUNTY"),
+		Tract:      attribute(tract, "TRACT"),
+		BlockGroup: attribute(firstArea(geographies, "Census Blocks"), "BLKGRP"),
+		Place:      attribute(firstArea(geographies, "Incorporated Places"), "PLACE"),
+	}
+	if codes.State == "" || codes.County == "" || codes.Tract == "" {
+		return nil, errNoTract
+	}
+	return codes, nil
+}
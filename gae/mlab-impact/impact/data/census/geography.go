@@ -0,0 +1,90 @@
+package census
+
+import (
+	"fmt"
+	"impact/data/cache"
+	"impact/data/census/api"
+	"impact/data/model"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// Census geographies a query can ask figures for, with the request parameter naming them
+const (
+	GeographyParameter = "censusGeography"
+
+	BlockGroupGeography = "blockgroup"
+	TractGeography      = "tract"
+	ZCTAGeography       = "zcta"
+	PlaceGeography      = "place"
+	CountyGeography     = "county"
+	StateGeography      = "state"
+)
+
+// Used when the request names no geography, or one that is unknown
+const DefaultGeography = CountyGeography
+
+var zipPattern = regexp.MustCompile(`^([0-9]{5})(-[0-9]{4})?$`)
+
+// Reads the geography a request asks census figures for
+func RequestedGeography(r *http.Request) string {
+	switch level := r.FormValue(GeographyParameter); level {
+	case BlockGroupGeography, TractGeography, ZCTAGeography, PlaceGeography, CountyGeography, StateGeography:
+		return level
+	}
+	return DefaultGeography
+}
+
+/*
+ * Returns the census geography of loc at level, with the level actually
+ * used. Levels that cannot be resolved for loc fall back to its county and
+ * then its state. Tracts, block groups and places are looked up from the
+ * coordinates of loc, ZCTAs from its ZIP code. Reports false for locations
+ * outside the states.
+ */
+func LocationGeography(r *http.Request, loc *model.Location, level string) (*api.Geography, string, bool) {
+	if loc.Country != "United States" {
+		return nil, "", false
+	}
+
+	switch level {
+	case BlockGroupGeography, TractGeography, PlaceGeography:
+		if loc.Lat == 0 && loc.Lng == 0 {
+			break
+		}
+		codes, err := Locate(r, loc.Lat, loc.Lng)
+		if err != nil {
+			break
+		}
+		if geo, ok := codes.geography(level); ok {
+			return geo, level, true
+		}
+	case ZCTAGeography:
+		if match := zipPattern.FindStringSubmatch(loc.Zip); match != nil {
+			return api.ForZCTA("", match[1]), level, true
+		}
+	}
+
+	county, state := CountyAndStateCodes(loc.County, loc.Region)
+	if state == "" {
+		return nil, "", false
+	}
+	if county == "" || level == StateGeography {
+		return api.ForState(state), StateGeography, true
+	}
+	return api.ForCounty(state, county), CountyGeography, true
+}
+
+/*
+ * The parts of a query that pick the census figures of its locations: the
+ * topics and geography asked for and, as finer geographies come from them,
+ * the ZIP code and coordinates of each location.
+ */
+func CacheKey(r *http.Request, clientLoc *model.Location, serverLoc *model.Location) string {
+	key := strings.Join(RequestedTopics(r), ",") + "|" + RequestedGeography(r)
+	for _, loc := range []*model.Location{clientLoc, serverLoc} {
+		key += fmt.Sprintf("|%v|%.4f,%.4f", cache.LocationKey(loc), loc.Lat, loc.Lng)
+	}
+	return key
+}
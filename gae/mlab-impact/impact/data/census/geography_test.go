@@ -0,0 +1,70 @@
+package census
+
+// Unit tests for picking the census geography of a location.
+
+import (
+	"impact/data/census/api"
+	"impact/data/model"
+	"net/http"
+	"testing"
+)
+
+var durham = &model.Location{Country: "United States", Region: "North Carolina", County: "Durham", Zip: "27701-1234"}
+
+// Makes sure that ZIP codes map to their ZCTA and other levels fall back.
+func TestLocationGeography(t *testing.T) {
+	tests := []struct {
+		level    string
+		expected string
+		used     string
+	}{
+		{ZCTAGeography, "for=zip+code+tabulation+area%3A27701", ZCTAGeography},
+		{CountyGeography, "for=county%3A063&in=state%3A37", CountyGeography},
+		{StateGeography, "for=state%3A37", StateGeography},
+		// no coordinates to find the tract from
+		{TractGeography, "for=county%3A063&in=state%3A37", CountyGeography},
+	}
+	for _, test := range tests {
+		geo, used, ok := LocationGeography(nil, durham, test.level)
+		if !ok || geo.String() != test.expected || used != test.used {
+			t.Errorf("TestLocationGeography %v got %v at %v", test.level, geo, used)
+		}
+	}
+
+	if _, _, ok := LocationGeography(nil, &model.Location{Country: "Canada", Zip: "27701"}, ZCTAGeography); ok {
+		t.Errorf("TestLocationGeography found a geography outside the states")
+	}
+}
+
+// Makes sure that unknown geographies fall back to the default.
+func TestRequestedGeography(t *testing.T) {
+	for param, expected := range map[string]string{"tract": TractGeography, "": DefaultGeography, "planet": DefaultGeography} {
+		r, _ := http.NewRequest("GET", "/query?censusGeography="+param, nil)
+		if level := RequestedGeography(r); level != expected {
+			t.Errorf("TestRequestedGeography %q = %v", param, level)
+		}
+	}
+}
+
+// Makes sure that the codes of every layer are read from the geocoder.
+func TestParseGeocoderResponse(t *testing.T) {
+	body := `{"result":{"geographies":{
+		"Census Tracts":[{"STATE":"37","COUNTY":"063","TRACT":"001502","AREALAND":1234}],
+		"Census Blocks":[{"BLKGRP":"2"}],
+		"Incorporated Places":[{"PLACE":"19000"}]}}}`
+	codes, err := parseGeocoderResponse([]byte(body))
+	if err != nil {
+		t.Fatalf("TestParseGeocoderResponse err = %v", err)
+	}
+	expected := Codes{State: "37", County: "063", Tract: "001502", BlockGroup: "2", Place: "19000"}
+	if *codes != expected {
+		t.Errorf("TestParseGeocoderResponse got %+v", codes)
+	}
+	if geo, ok := codes.geography(BlockGroupGeography); !ok || geo.Area != (api.Area{Level: api.BlockGroup, Code: "2"}) || len(geo.In) != 3 {
+		t.Errorf("TestParseGeocoderResponse block group = %+v", geo)
+	}
+
+	if _, err := parseGeocoderResponse([]byte(`{"result":{"geographies":{}}}`)); err != errNoTract {
+		t.Errorf("TestParseGeocoderResponse outside any tract err = %v", err)
+	}
+}
@@ -0,0 +1,74 @@
+package census
+
+import (
+	"sync"
+	"time"
+)
+
+/*
+ * Census API requests an instance may send a second, and how many it may
+ * send at once after a quiet spell. The API rate limits each key, so every
+ * request of an instance, geocoder lookups included, shares DefaultLimiter.
+ */
+var (
+	RequestsPerSecond = 20.0
+	RequestBurst      = 40
+)
+
+var DefaultLimiter = NewLimiter(RequestsPerSecond, RequestBurst)
+
+/*
+ * A token bucket that fills at Rate tokens a second up to Burst tokens.
+ * Each request takes a token, waiting for one when the bucket is empty.
+ */
+type Limiter struct {
+	Rate  float64
+	Burst int
+	// Clock and sleeper, replaced by tests
+	Now   func() time.Time
+	Sleep func(time.Duration)
+
+	mutex  sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func NewLimiter(rate float64, burst int) *Limiter {
+	return &Limiter{
+		Rate:   rate,
+		Burst:  burst,
+		Now:    time.Now,
+		Sleep:  time.Sleep,
+		tokens: float64(burst),
+	}
+}
+
+/*
+ * Takes a token, returning how long the caller has to wait before it may be
+ * used. Tokens are handed out ahead of time, leaving the bucket in debt, so
+ * that waiting callers are served in order.
+ */
+func (l *Limiter) reserve() time.Duration {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	now := l.Now()
+	if !l.last.IsZero() {
+		l.tokens += now.Sub(l.last).Seconds() * l.Rate
+		if l.tokens > float64(l.Burst) {
+			l.tokens = float64(l.Burst)
+		}
+	}
+	l.last = now
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.Rate * float64(time.Second))
+}
+
+// Blocks until the caller may send a request
+func (l *Limiter) Wait() {
+	if wait := l.reserve(); wait > 0 {
+		l.Sleep(wait)
+	}
+}
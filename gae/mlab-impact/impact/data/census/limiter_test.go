@@ -0,0 +1,33 @@
+package census
+
+// Unit tests for the token bucket limiting Census API requests.
+
+import (
+	"testing"
+	"time"
+)
+
+// Makes sure that bursts pass and later requests wait for their token.
+func TestLimiter(t *testing.T) {
+	now := time.Date(2013, 6, 1, 0, 0, 0, 0, time.UTC)
+	limiter := NewLimiter(2, 2)
+	limiter.Now = func() time.Time { return now }
+
+	waits := []time.Duration{}
+	for i := 0; i < 4; i++ {
+		waits = append(waits, limiter.reserve())
+	}
+	expected := []time.Duration{0, 0, 500 * time.Millisecond, time.Second}
+	for i := range expected {
+		if waits[i] != expected[i] {
+			t.Errorf("TestLimiter waits got %v, expected %v", waits, expected)
+			break
+		}
+	}
+
+	// the debt is paid off after a second and the bucket refills up to its burst
+	now = now.Add(10 * time.Second)
+	if wait := limiter.reserve(); wait != 0 || limiter.tokens != 1 {
+		t.Errorf("TestLimiter after refilling waits %v with %v tokens left", wait, limiter.tokens)
+	}
+}
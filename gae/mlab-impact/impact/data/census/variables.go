@@ -0,0 +1,314 @@
+package census
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"impact/data/model"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Request parameter naming, comma separated, the topics to fetch
+const TopicsParameter = "topics"
+
+/*
+ * File the variable catalog is read from, relative to the application
+ * directory, which App Engine runs the app in.
+ */
+var VariablesFile = "census_variables.json"
+
+var errNoVariableSet = errors.New("The dataset names no variable set")
+
+// Most variables a query may list; the Census API takes 50 at a time, margins included
+const MaxCustomVariables = 25
+
+var variableCode = regexp.MustCompile(`^[A-Z][A-Z0-9]*_?[0-9A-Z]*$`)
+
+// A census variable and the label it is reported under
+type Variable struct {
+	Code  string `json:"code"`
+	Label string `json:"label"`
+}
+
+// A named group of variables, e.g. income, fetched together
+type Topic struct {
+	Name      string      `json:"name"`
+	Label     string      `json:"label"`
+	Variables []*Variable `json:"variables"`
+}
+
+/*
+ * The topics of each set of variables, keyed by set name, e.g. acs. Each
+ * dataset names the set it is queried with; datasets share a set until the
+ * Census Bureau renumbers a variable between vintages or products, which
+ * then gets a set of its own. /admin/variables checks a set against each
+ * dataset.
+ */
+type VariableCatalog map[string][]*Topic
+
+// Decodes a variable catalog, refusing unnamed or repeated topics and variables without codes
+func ParseVariables(data []byte) (VariableCatalog, error) {
+	catalog := VariableCatalog{}
+	if err := json.Unmarshal(data, &catalog); err != nil {
+		return nil, err
+	}
+	for set, topics := range catalog {
+		names := make(map[string]bool)
+		for _, topic := range topics {
+			if topic.Name == "" || names[topic.Name] {
+				return nil, fmt.Errorf("Variable set %v has an unnamed or repeated topic %q", set, topic.Name)
+			}
+			names[topic.Name] = true
+			for _, variable := range topic.Variables {
+				if variable.Code == "" || variable.Label == "" {
+					return nil, fmt.Errorf("Topic %v of %v has a variable without code or label", topic.Name, set)
+				}
+			}
+		}
+	}
+	return catalog, nil
+}
+
+func ReadVariables(path string) (VariableCatalog, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVariables(data)
+}
+
+var (
+	variablesOnce sync.Once
+	variables     VariableCatalog
+	variablesErr  error
+)
+
+// Returns the catalog in VariablesFile, read on first use
+func LoadVariables() (VariableCatalog, error) {
+	variablesOnce.Do(func() {
+		variables, variablesErr = ReadVariables(VariablesFile)
+	})
+	return variables, variablesErr
+}
+
+// Whether any set has a topic with this name
+func (vc VariableCatalog) hasTopic(name string) bool {
+	for _, topics := range vc {
+		for _, topic := range topics {
+			if topic.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+/*
+ * Returns the code and label pairs of the named topics of a set, or of all
+ * its topics when names is empty. Topics the set does not have are skipped,
+ * as they may belong to another source's set.
+ */
+func (vc VariableCatalog) Fields(set string, names []string) [][]string {
+	wanted := make(map[string]bool, len(names))
+	for _, name := range names {
+		wanted[name] = true
+	}
+	fields := [][]string{}
+	for _, topic := range vc[set] {
+		if len(names) > 0 && !wanted[topic.Name] {
+			continue
+		}
+		fields = append(fields, topicFields(topic)...)
+	}
+	return fields
+}
+
+// Returns the topics a request asks for, lower case, sorted and without repeats
+func RequestedTopics(r *http.Request) []string {
+	if r == nil {
+		return nil
+	}
+	seen := make(map[string]bool)
+	topics := []string{}
+	for _, name := range strings.Split(r.FormValue(TopicsParameter), ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name != "" && !seen[name] {
+			seen[name] = true
+			topics = append(topics, name)
+		}
+	}
+	sort.Strings(topics)
+	return topics
+}
+
+func loadVariables() (VariableCatalog, error) {
+	catalog, err := LoadVariables()
+	if err != nil {
+		return nil, model.NewSourceError(model.ErrCodeFailed, err)
+	}
+	return catalog, nil
+}
+
+// The topics of the dataset's variable set
+func (d *Dataset) Topics() ([]*Topic, error) {
+	catalog, err := loadVariables()
+	if err != nil {
+		return nil, err
+	}
+	topics, ok := catalog[d.Variables]
+	if !ok {
+		return nil, model.NewSourceError(model.ErrCodeFailed, errNoVariableSet)
+	}
+	return topics, nil
+}
+
+/*
+ * Returns the fields of the dataset a request asks for through its topics,
+ * failing with ErrCodeInvalidRequest when a topic is in no variable set.
+ * The fields are empty when every requested topic belongs to other sets.
+ */
+func (d *Dataset) Fields(r *http.Request) ([][]string, error) {
+	catalog, err := loadVariables()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := catalog[d.Variables]; !ok {
+		return nil, model.NewSourceError(model.ErrCodeFailed, errNoVariableSet)
+	}
+	topics := RequestedTopics(r)
+	for _, name := range topics {
+		if !catalog.hasTopic(name) {
+			return nil, model.NewSourceError(model.ErrCodeInvalidRequest, fmt.Errorf("Unknown census topic %q", name))
+		}
+	}
+	return catalog.Fields(d.Variables, topics), nil
+}
+
+/*
+ * Parses a list of variables given with a query, comma separated codes each
+ * optionally followed by a colon and the label to report it under, e.g.
+ * B19013_001E:Median household income. Variables without a label are
+ * reported under their code. Labels naming a level like one of the figures
+ * of a table, e.g. share, are refused.
+ */
+func ParseCustomVariables(list string) ([][]string, error) {
+	fields := [][]string{}
+	seen := make(map[string]bool)
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		code, label := entry, ""
+		if index := strings.Index(entry, ":"); index != -1 {
+			code, label = entry[:index], strings.TrimSpace(entry[index+1:])
+		}
+		code = strings.ToUpper(strings.TrimSpace(code))
+		if !variableCode.MatchString(code) {
+			return nil, fmt.Errorf("Malformed census variable %q", code)
+		}
+		if label == "" {
+			label = code
+		}
+		if model.ReservedLabel(label) {
+			return nil, fmt.Errorf("Census variable %v cannot be labeled %q, which names a figure", code, label)
+		}
+		if !seen[code] {
+			seen[code] = true
+			fields = append(fields, []string{code, label})
+		}
+	}
+	if len(fields) > MaxCustomVariables {
+		return nil, fmt.Errorf("At most %v census variables can be asked for, got %v", MaxCustomVariables, len(fields))
+	}
+	return fields, nil
+}
+
+/*
+ * Returns the fields a request asks for from dataset: the variables it
+ * lists under VariablesParameter, else those of the topics it asks for.
+ */
+func (c *Catalog) Fields(r *http.Request, dataset *Dataset) ([][]string, error) {
+	list := r.FormValue(c.VariablesParameter)
+	if strings.TrimSpace(list) == "" {
+		return dataset.Fields(r)
+	}
+	fields, err := ParseCustomVariables(list)
+	if err != nil {
+		return nil, model.NewSourceError(model.ErrCodeInvalidRequest, err)
+	}
+	return fields, nil
+}
+
+/*
+ * Keys the figures a request asks a census source for: the dataset, any
+ * custom variables and the parts CacheKey covers. Reports false for
+ * requests that will fail.
+ */
+func (c *Catalog) CacheKey(r *http.Request, clientLoc *model.Location, serverLoc *model.Location) (string, bool) {
+	dataset, err := c.Select(r)
+	if err != nil {
+		return "", false
+	}
+	custom := ""
+	if list := r.FormValue(c.VariablesParameter); strings.TrimSpace(list) != "" {
+		fields, err := ParseCustomVariables(list)
+		if err != nil {
+			return "", false
+		}
+		custom = fmt.Sprintf("%q", fields)
+	}
+	return dataset.Key() + "|" + custom + "|" + CacheKey(r, clientLoc, serverLoc), true
+}
+
+// Labels of the topics of the catalog's default dataset, nil if the variables cannot be read
+func (c *Catalog) Provides() []string {
+	topics, err := c.datasets[c.Default].Topics()
+	if err != nil {
+		return nil
+	}
+	labels := []string{}
+	for _, topic := range topics {
+		labels = append(labels, topic.Label)
+	}
+	return labels
+}
+
+func topicFields(topic *Topic) [][]string {
+	fields := [][]string{}
+	for _, variable := range topic.Variables {
+		fields = append(fields, []string{variable.Code, variable.Label})
+	}
+	return fields
+}
+
+/*
+ * Checks the variables of a dataset, and their margins of error if it has
+ * any, against the dataset's metadata on the Census API, returning the codes
+ * the API does not know.
+ */
+func CheckVariables(r *http.Request, dataset *Dataset) ([]string, error) {
+	topics, err := dataset.Topics()
+	if err != nil {
+		return nil, err
+	}
+	known, err := DefaultCensusRequester().client(r).Variables(dataset.URL())
+	if err != nil {
+		return nil, sourceError(err)
+	}
+	requester := &CensusRequester{Margins: dataset.Margins}
+	unknown := []string{}
+	for _, topic := range topics {
+		for _, code := range requester.variables(topicFields(topic)) {
+			if _, ok := known[code]; !ok {
+				unknown = append(unknown, code)
+			}
+		}
+	}
+	return unknown, nil
+}
@@ -0,0 +1,164 @@
+package census
+
+// Unit tests for the census variable catalog.
+
+import (
+	"fmt"
+	"impact/data/model"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+var testVariables = []byte(`{
+	"acs-2010": [
+		{"name": "income", "label": "Income", "variables": [
+			{"code": "B19101_002E", "label": "Income - Less than $10,000"},
+			{"code": "B19101_003E", "label": "Income - $10,000 to $14,999"}
+		]},
+		{"name": "citizenship", "label": "Sex by Age by Citizenship Status", "variables": [
+			{"code": "B05003F_001E", "label": "Sex by Age by Citizenship Status - Total"}
+		]}
+	],
+	"sf1-2010": [
+		{"name": "race", "label": "Race", "variables": [
+			{"code": "P0030001", "label": "Total population"}
+		]}
+	]
+}`)
+
+// Makes sure that topics pick their variables and unknown topics are skipped.
+func TestVariableCatalogFields(t *testing.T) {
+	catalog, err := ParseVariables(testVariables)
+	if err != nil {
+		t.Fatalf("TestVariableCatalogFields:ParseVariables err = %v", err)
+	}
+	if fields := catalog.Fields("acs-2010", nil); len(fields) != 3 {
+		t.Errorf("TestVariableCatalogFields all topics got %v", fields)
+	}
+	fields := catalog.Fields("acs-2010", []string{"citizenship", "race"})
+	if len(fields) != 1 || fields[0][0] != "B05003F_001E" {
+		t.Errorf("TestVariableCatalogFields citizenship got %v", fields)
+	}
+	if fields := catalog.Fields("acs-2010", []string{"race"}); len(fields) != 0 {
+		t.Errorf("TestVariableCatalogFields took another set's topic, got %v", fields)
+	}
+	if !catalog.hasTopic("race") || catalog.hasTopic("age") {
+		t.Errorf("TestVariableCatalogFields hasTopic is wrong")
+	}
+}
+
+// Makes sure that malformed catalogs are refused.
+func TestParseVariablesErrors(t *testing.T) {
+	for _, data := range []string{
+		`[`,
+		`{"a": [{"name": "", "variables": []}]}`,
+		`{"a": [{"name": "x", "variables": []}, {"name": "x", "variables": []}]}`,
+		`{"a": [{"name": "x", "variables": [{"code": "", "label": "Total"}]}]}`,
+	} {
+		if _, err := ParseVariables([]byte(data)); err == nil {
+			t.Errorf("TestParseVariablesErrors accepted %v", data)
+		}
+	}
+}
+
+// Makes sure that the topics parameter is normalized.
+func TestRequestedTopics(t *testing.T) {
+	r, _ := http.NewRequest("GET", "/query?topics=Race,+income,,race", nil)
+	topics := RequestedTopics(r)
+	if len(topics) != 2 || topics[0] != "income" || topics[1] != "race" {
+		t.Errorf("TestRequestedTopics got %v", topics)
+	}
+}
+
+// Makes sure that the deployed catalog parses and has the sets the sources query.
+func TestDeployedVariables(t *testing.T) {
+	catalog, err := ReadVariables("../../../" + VariablesFile)
+	if err != nil {
+		t.Fatalf("TestDeployedVariables:ReadVariables err = %v", err)
+	}
+	for _, set := range []string{"acs", "sf1-2010"} {
+		fields := catalog.Fields(set, nil)
+		if len(fields) == 0 {
+			t.Errorf("TestDeployedVariables set %v has no variables", set)
+		}
+		// a variable at the top level has no parent to take its share of
+		for _, field := range fields {
+			if !strings.Contains(field[1], model.LabelSeparator) {
+				t.Errorf("TestDeployedVariables %v of set %v is not nested under a total", field[1], set)
+			}
+		}
+	}
+}
+
+// Makes sure that the deployed census topics give their counts shares of the topic total.
+func TestDeployedShares(t *testing.T) {
+	catalog, err := ReadVariables("../../../" + VariablesFile)
+	if err != nil {
+		t.Fatalf("TestDeployedShares:ReadVariables err = %v", err)
+	}
+	table := model.NewCensusTable()
+	for _, field := range catalog.Fields("sf1-2010", []string{"race"}) {
+		table.Space(field[1]).Value = &model.CensusValue{Total: 10}
+	}
+	table.Space("Race - Total").Value = &model.CensusValue{Total: 80}
+	table.ComputeShares()
+	white := table.Children["Race"].Children["White alone"]
+	if white == nil || white.Share == nil || *white.Share != 12.5 {
+		t.Errorf("TestDeployedShares got White alone %+v", white)
+	}
+}
+
+// Makes sure that custom variable lists are parsed with their labels and checked.
+func TestParseCustomVariables(t *testing.T) {
+	fields, err := ParseCustomVariables(" b19013_001e:Median household income, P0030001,,B19013_001E")
+	if err != nil {
+		t.Fatalf("TestParseCustomVariables:ParseCustomVariables err = %v", err)
+	}
+	expected := [][]string{{"B19013_001E", "Median household income"}, {"P0030001", "P0030001"}}
+	if len(fields) != len(expected) {
+		t.Fatalf("TestParseCustomVariables got %v", fields)
+	}
+	for i := range expected {
+		if fields[i][0] != expected[i][0] || fields[i][1] != expected[i][1] {
+			t.Errorf("TestParseCustomVariables got %v, expected %v", fields, expected)
+		}
+	}
+
+	tooMany := []string{}
+	for i := 0; i <= MaxCustomVariables; i++ {
+		tooMany = append(tooMany, fmt.Sprintf("P003%04d", i))
+	}
+	for _, list := range []string{"B19013_001E&for=state:*", "P003 0001", strings.Join(tooMany, ","),
+		"B19013_001E:share", "B19013_001E:Income - total", "P0030001:null"} {
+		if _, err := ParseCustomVariables(list); err == nil {
+			t.Errorf("TestParseCustomVariables accepted %q", list)
+		}
+	}
+}
+
+// Makes sure that custom variables replace the topics and are part of the cache key.
+func TestCatalogCustomFields(t *testing.T) {
+	r, _ := http.NewRequest("GET", "/query?testDataset=2010/acs5&testVariables=B19013_001E&topics=bogus", nil)
+	dataset, _ := testCatalog.Lookup("2010/acs5")
+	fields, err := testCatalog.Fields(r, dataset)
+	if err != nil || len(fields) != 1 || fields[0][0] != "B19013_001E" {
+		t.Errorf("TestCatalogCustomFields got %v, %v", fields, err)
+	}
+
+	loc := &model.Location{Country: "United States"}
+	key, ok := testCatalog.CacheKey(r, loc, loc)
+	plain, _ := http.NewRequest("GET", "/query?testDataset=2010/acs5&topics=bogus", nil)
+	plainKey, _ := testCatalog.CacheKey(plain, loc, loc)
+	if !ok || key == plainKey {
+		t.Errorf("TestCatalogCustomFields cache keys %q and %q do not tell the variables apart", key, plainKey)
+	}
+
+	bad, _ := http.NewRequest("GET", "/query?testDataset=2010/acs5&testVariables=B1-drop", nil)
+	if _, err := testCatalog.Fields(bad, dataset); err == nil {
+		t.Errorf("TestCatalogCustomFields accepted a malformed variable")
+	}
+	if _, ok := testCatalog.CacheKey(bad, loc, loc); ok {
+		t.Errorf("TestCatalogCustomFields cached a request that will fail")
+	}
+}
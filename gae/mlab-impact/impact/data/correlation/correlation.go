@@ -0,0 +1,330 @@
+/*
+ * Package correlation relates the network performance measured by NDT to
+ * the demographics of the places it was measured in, across the counties
+ * of a state.
+ */
+package correlation
+
+import (
+	"errors"
+	"fmt"
+	"impact/data/cache"
+	"impact/data/census"
+	"impact/data/census/acs"
+	"impact/data/model"
+	"impact/data/ndt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Census variable compared when the request names none
+const (
+	DefaultVariable = "B19013_001E"
+	DefaultLabel    = "Median household income"
+)
+
+// Counties with fewer tests are too noisy to be compared
+var MinCountyTests int64 = 30
+
+// How long a correlation may take, within the minute App Engine gives a request
+var Deadline = 50 * time.Second
+
+// Cities kept in memory by each instance in front of memcache
+const DefaultCityCacheSize = 1024
+
+/*
+ * The state and county FIPS codes the geocoder placed each city in, keyed
+ * by state and city name, so that a city is only geocoded once. Cities do
+ * not move, so they are kept for as long as memcache allows.
+ */
+var (
+	CityCache    cache.Backend = cache.Tiered{cache.NewLRU(DefaultCityCacheSize), &cache.Memcache{Prefix: "city:"}}
+	CityCacheTTL               = 30 * 24 * time.Hour
+)
+
+// Correlations kept in memory by each instance in front of memcache
+const DefaultResultCacheSize = 64
+
+/*
+ * Correlations already computed, keyed by their Request. New tests keep
+ * arriving, so they stay fresh for as long as NDT results do.
+ */
+var (
+	ResultCache    = cache.New(cache.Tiered{cache.NewLRU(DefaultResultCacheSize), &cache.Memcache{Prefix: "correlation:"}})
+	ResultCacheTTL = 6 * time.Hour
+)
+
+// Finds the census areas around a point; replaced by tests
+var locate = census.Locate
+
+// Network metrics compared, with their units
+var correlatedMetrics = []struct {
+	name string
+	unit string
+}{
+	{ndt.ThroughputMetric, "Mbit/s"},
+	{ndt.RTTMetric, "ms"},
+}
+
+var (
+	errNoState     = errors.New("A correlation needs the state whose counties are compared")
+	errOneVariable = errors.New("A correlation compares a single census variable")
+	errDeadline    = errors.New("The correlation did not finish before its deadline")
+)
+
+// What a correlation compares: a variable of an ACS dataset against the tests of a time range
+type Request struct {
+	State     *census.State
+	Variable  string
+	Label     string
+	Dataset   *census.Dataset
+	TimeRange *ndt.TimeRange
+}
+
+func invalid(err error) error {
+	return model.NewSourceError(model.ErrCodeInvalidRequest, err)
+}
+
+/*
+ * Reads a correlation request: state, as a name, postal abbreviation or
+ * FIPS code, variable as the census code optionally followed by a colon and
+ * a label, acsDataset, and the start and end of the tests.
+ */
+func ParseRequest(r *http.Request, now time.Time) (*Request, error) {
+	state, ok := census.DefaultGazetteer.State(r.FormValue("state"))
+	if !ok {
+		return nil, invalid(errNoState)
+	}
+	req := &Request{State: state, Variable: DefaultVariable, Label: DefaultLabel}
+
+	if variable := r.FormValue("variable"); variable != "" {
+		fields, err := census.ParseCustomVariables(variable)
+		if err != nil {
+			return nil, invalid(err)
+		}
+		if len(fields) != 1 {
+			return nil, invalid(errOneVariable)
+		}
+		req.Variable, req.Label = fields[0][0], fields[0][1]
+	}
+
+	dataset, err := acs.Datasets.Select(r)
+	if err != nil {
+		return nil, err
+	}
+	req.Dataset = dataset
+
+	timeRange, err := ndt.ParseTimeRange(r, now)
+	if err != nil {
+		return nil, invalid(err)
+	}
+	req.TimeRange = timeRange
+	return req, nil
+}
+
+/*
+ * The cache key of a request: its state, variable and label, dataset and
+ * the days its time range covers.
+ */
+func (req *Request) CacheKey() string {
+	return cache.Key("correlation", req.State.Code, req.Variable, req.Label, req.Dataset.Key(),
+		req.TimeRange.Start.Format(ndt.DateFormat), req.TimeRange.End.Format(ndt.DateFormat))
+}
+
+func timedOut() error {
+	return model.NewSourceError(model.ErrCodeTimeout, errDeadline)
+}
+
+/*
+ * Compares the network metrics of each county of the requested state with
+ * the requested census variable. Tests are placed in counties by the
+ * coordinates of their city, as NDT does not record counties. Fails with
+ * ErrCodeTimeout when it takes longer than Deadline. Results are kept in
+ * ResultCache, which, like the other caches, is best effort.
+ */
+func Correlate(r *http.Request, req *Request) (*model.Correlation, error) {
+	key := req.CacheKey()
+	cached := &model.Correlation{}
+	if ok, err := ResultCache.Get(r, key, cached); err == nil && ok {
+		return cached, nil
+	}
+	result, err := correlateRequest(r, req)
+	if err == nil {
+		ResultCache.Set(r, key, result, ResultCacheTTL)
+	}
+	return result, err
+}
+
+// Computes a correlation, for Correlate to cache
+func correlateRequest(r *http.Request, req *Request) (*model.Correlation, error) {
+	deadline := time.Now().Add(Deadline)
+	type censusResult struct {
+		figures []*census.CountyFigure
+		err     error
+	}
+	fromCensus := make(chan *censusResult, 1)
+	go func() {
+		figures, err := census.DefaultCensusRequester().AskCounties(r, req.Dataset.URL(), req.Variable, req.State.Code)
+		fromCensus <- &censusResult{figures, err}
+	}()
+
+	region := &model.Location{Country: "United States", Region: req.State.Name}
+	cities, err := ndt.NDT_Source().CityTests(r, req.TimeRange, region, deadline)
+	if err != nil {
+		return nil, err
+	}
+	var fetched *censusResult
+	select {
+	case fetched = <-fromCensus:
+	case <-time.After(deadline.Sub(time.Now())):
+		return nil, timedOut()
+	}
+	if fetched.err != nil {
+		return nil, fetched.err
+	}
+	counties, err := locateCities(r, req.State.Code, cities, deadline)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.Correlation{
+		State:    req.State.Name,
+		Variable: req.Variable,
+		Label:    req.Label,
+		Dataset:  req.Dataset.Key(),
+		Start:    req.TimeRange.Start,
+		End:      req.TimeRange.End,
+	}
+	result.Metrics, result.Skipped = correlate(fetched.figures, counties, MinCountyTests)
+	return result, nil
+}
+
+/*
+ * Returns the FIPS codes of the state and county city lies in, from
+ * CityCache or else by geocoding its coordinates.
+ */
+func cityCounty(r *http.Request, stateCode string, city *ndt.TestSums) (string, string, error) {
+	key := cache.Key("city", stateCode, city.Place)
+	if value, ok, err := CityCache.Get(r, key); err == nil && ok && len(value) > 2 {
+		return string(value[:2]), string(value[2:]), nil
+	}
+	codes, err := locate(r, city.Lat, city.Lng)
+	if err != nil {
+		return "", "", err
+	}
+	CityCache.Set(r, key, []byte(codes.State+codes.County), CityCacheTTL)
+	return codes.State, codes.County, nil
+}
+
+/*
+ * Adds the tests of each city up by the county, keyed by FIPS code, its
+ * coordinates fall in. Cities without coordinates, outside the state or
+ * that the geocoder cannot place are left out, as are the tests without a
+ * city, whose coordinates average tests from all over the state. Fails with ErrCodeTimeout
+ * when cities are left to place at deadline.
+ */
+func locateCities(r *http.Request, stateCode string, cities []*ndt.TestSums, deadline time.Time) (map[string]*ndt.TestSums, error) {
+	var mutex sync.Mutex
+	counties := make(map[string]*ndt.TestSums)
+	late := false
+
+	pending := make(chan *ndt.TestSums, len(cities))
+	for _, city := range cities {
+		if city.Place != "" && city.Tests > 0 && (city.Lat != 0 || city.Lng != 0) {
+			pending <- city
+		}
+	}
+	close(pending)
+
+	var wg sync.WaitGroup
+	for i := 0; i < census.DefaultWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for city := range pending {
+				if time.Now().After(deadline) {
+					mutex.Lock()
+					late = true
+					mutex.Unlock()
+					continue
+				}
+				state, county, err := cityCounty(r, stateCode, city)
+				if err != nil || state != stateCode || county == "" {
+					continue
+				}
+				mutex.Lock()
+				if counties[county] == nil {
+					counties[county] = ndt.NewTestSums(county)
+				}
+				counties[county].Add(city)
+				mutex.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if late {
+		return nil, timedOut()
+	}
+	return counties, nil
+}
+
+/*
+ * Pairs the census figure of each county with each of its network metrics
+ * and computes how they correlate. Returns the reason each county was
+ * left out, by county name.
+ */
+func correlate(figures []*census.CountyFigure, counties map[string]*ndt.TestSums, minTests int64) ([]*model.MetricCorrelation, map[string]string) {
+	skipped := make(map[string]string)
+	type county struct {
+		figure  *census.CountyFigure
+		tests   *ndt.TestSums
+		metrics map[string]float64
+	}
+	compared := []*county{}
+	for _, figure := range figures {
+		tests := counties[figure.County.Code]
+		switch {
+		case figure.Null != "":
+			skipped[figure.County.Name] = figure.Null
+		case tests == nil:
+			skipped[figure.County.Name] = "no tests"
+		case tests.Tests < minTests:
+			skipped[figure.County.Name] = fmt.Sprintf("%v tests, fewer than %v", tests.Tests, minTests)
+		default:
+			compared = append(compared, &county{figure, tests, tests.Metrics()})
+		}
+	}
+
+	correlations := []*model.MetricCorrelation{}
+	for _, metric := range correlatedMetrics {
+		correlation := &model.MetricCorrelation{Metric: metric.name, Unit: metric.unit, Points: []*model.CorrelationPoint{}}
+		xs, ys := []float64{}, []float64{}
+		for _, c := range compared {
+			y, ok := c.metrics[metric.name]
+			if !ok {
+				continue
+			}
+			correlation.Points = append(correlation.Points, &model.CorrelationPoint{
+				County: c.figure.County.Name,
+				FIPS:   c.figure.County.StateCode + c.figure.County.Code,
+				X:      c.figure.Value,
+				Y:      y,
+				Tests:  c.tests.Tests,
+			})
+			xs = append(xs, c.figure.Value)
+			ys = append(ys, y)
+		}
+		if pearson, ok := Pearson(xs, ys); ok {
+			correlation.Pearson = &pearson
+		}
+		if spearman, ok := Spearman(xs, ys); ok {
+			correlation.Spearman = &spearman
+		}
+		if regression, ok := LinearRegression(xs, ys); ok {
+			correlation.Regression = regression
+		}
+		correlations = append(correlations, correlation)
+	}
+	return correlations, skipped
+}
@@ -0,0 +1,164 @@
+package correlation
+
+// Unit tests for comparing the counties of a state.
+
+import (
+	"impact/data/cache"
+	"impact/data/census"
+	"impact/data/model"
+	"impact/data/ndt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func testCounty(code string, name string, value float64, null string) *census.CountyFigure {
+	return &census.CountyFigure{
+		County: &census.County{StateCode: "37", Code: code, Name: name},
+		Value:  value,
+		Null:   null,
+	}
+}
+
+func testTests(county string, tests int64, octets float64, rtt float64) *ndt.TestSums {
+	sums := ndt.NewTestSums(county)
+	sums.Tests = tests
+	values := map[string]float64{"DataOctetsOut": octets, "Duration": 1000000, "SumRTT": rtt, "CountRTT": 1}
+	for field, value := range values {
+		sums.Sums[field] = value * float64(tests)
+		sums.Counts[field] = tests
+	}
+	return sums
+}
+
+// Makes sure that counties without figures or enough tests are skipped and the rest compared.
+func TestCorrelate(t *testing.T) {
+	figures := []*census.CountyFigure{
+		testCounty("001", "Alamance", 40000, ""),
+		testCounty("063", "Durham", 50000, ""),
+		testCounty("135", "Orange", 60000, ""),
+		testCounty("065", "Edgecombe", 0, "too few sample observations to compute"),
+		testCounty("067", "Forsyth", 45000, ""),
+		testCounty("069", "Franklin", 42000, ""),
+	}
+	counties := map[string]*ndt.TestSums{
+		"001": testTests("001", 40, 1000000, 90),
+		"063": testTests("063", 50, 2000000, 60),
+		"135": testTests("135", 60, 3000000, 30),
+		"065": testTests("065", 50, 1000000, 50),
+		"069": testTests("069", 5, 1000000, 50),
+	}
+
+	metrics, skipped := correlate(figures, counties, 30)
+	for _, name := range []string{"Edgecombe", "Forsyth", "Franklin"} {
+		if skipped[name] == "" {
+			t.Errorf("TestCorrelate did not skip %v, skipped %v", name, skipped)
+		}
+	}
+	if len(skipped) != 3 {
+		t.Errorf("TestCorrelate skipped %v", skipped)
+	}
+	if len(metrics) != 2 || metrics[0].Metric != ndt.ThroughputMetric || metrics[1].Metric != ndt.RTTMetric {
+		t.Fatalf("TestCorrelate got metrics %+v", metrics)
+	}
+	throughput, rtt := metrics[0], metrics[1]
+	if len(throughput.Points) != 3 || throughput.Points[1].FIPS != "37063" || throughput.Points[1].Tests != 50 {
+		t.Errorf("TestCorrelate got points %+v", throughput.Points)
+	}
+	if throughput.Pearson == nil || !near(*throughput.Pearson, 1) {
+		t.Errorf("TestCorrelate throughput pearson got %v", throughput.Pearson)
+	}
+	if rtt.Spearman == nil || !near(*rtt.Spearman, -1) || rtt.Regression == nil || rtt.Regression.Slope >= 0 {
+		t.Errorf("TestCorrelate rtt got spearman %v, regression %+v", rtt.Spearman, rtt.Regression)
+	}
+}
+
+// Makes sure that a single county gives points but no coefficients.
+func TestCorrelateSingleCounty(t *testing.T) {
+	figures := []*census.CountyFigure{testCounty("063", "Durham", 50000, "")}
+	counties := map[string]*ndt.TestSums{"063": testTests("063", 50, 2000000, 60)}
+	metrics, _ := correlate(figures, counties, 30)
+	if len(metrics[0].Points) != 1 || metrics[0].Pearson != nil || metrics[0].Regression != nil {
+		t.Errorf("TestCorrelateSingleCounty got %+v", metrics[0])
+	}
+}
+
+// Makes sure that named cities are added up by county, geocoded once, and given up on at the deadline.
+func TestLocateCities(t *testing.T) {
+	cityCache, geocoder := CityCache, locate
+	defer func() {
+		CityCache, locate = cityCache, geocoder
+	}()
+	CityCache = cache.NewLRU(8)
+	var mutex sync.Mutex
+	lookups := 0
+	locate = func(r *http.Request, lat float64, lng float64) (*census.Codes, error) {
+		mutex.Lock()
+		lookups++
+		mutex.Unlock()
+		if lat > 36.5 {
+			return &census.Codes{State: "51", County: "590", Tract: "000100"}, nil
+		}
+		return &census.Codes{State: "37", County: "063", Tract: "000100"}, nil
+	}
+	city := func(name string, lat float64, tests int64) *ndt.TestSums {
+		sums := testTests(name, tests, 1000000, 50)
+		sums.Lat, sums.Lng = lat, -78.9
+		return sums
+	}
+	cities := []*ndt.TestSums{city("Durham", 36.0, 40), city("Bahama", 36.2, 10), city("Danville", 36.6, 20)}
+	// the tests geolocated no closer than the state
+	unnamed := city("", 35.5, 500)
+
+	deadline := time.Now().Add(time.Minute)
+	for i := 0; i < 2; i++ {
+		counties, err := locateCities(nil, "37", append(cities, unnamed), deadline)
+		if err != nil || len(counties) != 1 || counties["063"] == nil || counties["063"].Tests != 50 {
+			t.Errorf("TestLocateCities got %+v, %v", counties, err)
+		}
+	}
+	if lookups != len(cities) {
+		t.Errorf("TestLocateCities geocoded %v times", lookups)
+	}
+
+	_, err := locateCities(nil, "37", cities, time.Now().Add(-time.Second))
+	if sourceErr, ok := err.(*model.SourceError); !ok || sourceErr.Code != model.ErrCodeTimeout {
+		t.Errorf("TestLocateCities past its deadline got %v", err)
+	}
+}
+
+// Makes sure that correlations are answered from the cache, keyed by everything they depend on.
+func TestCorrelateCached(t *testing.T) {
+	resultCache := ResultCache
+	defer func() {
+		ResultCache = resultCache
+	}()
+	ResultCache = cache.New(cache.NewLRU(4))
+
+	r, _ := http.NewRequest("GET", "/correlation?state=NC&start=2012-06-01&end=2012-06-30", nil)
+	req, err := ParseRequest(r, time.Date(2012, 7, 12, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("TestCorrelateCached:ParseRequest err = %v", err)
+	}
+	pearson := 0.5
+	cached := &model.Correlation{
+		State:   "North Carolina",
+		Metrics: []*model.MetricCorrelation{{Metric: ndt.ThroughputMetric, Pearson: &pearson}},
+		Skipped: map[string]string{"Hyde": "no tests"},
+	}
+	ResultCache.Set(nil, req.CacheKey(), cached, time.Hour)
+
+	// a miss would query BigQuery, which needs an App Engine request
+	result, err := Correlate(nil, req)
+	if err != nil || result.State != cached.State || len(result.Metrics) != 1 ||
+		*result.Metrics[0].Pearson != pearson || result.Skipped["Hyde"] != "no tests" {
+		t.Errorf("TestCorrelateCached got %+v, %v", result, err)
+	}
+
+	other := *req
+	other.Variable = "B01003_001E"
+	if other.CacheKey() == req.CacheKey() {
+		t.Errorf("TestCorrelateCached shared a key between variables")
+	}
+}
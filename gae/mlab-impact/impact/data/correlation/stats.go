@@ -0,0 +1,100 @@
+package correlation
+
+import (
+	"impact/data/model"
+	"math"
+	"sort"
+)
+
+func mean(values []float64) float64 {
+	sum := 0.0
+	for _, value := range values {
+		sum += value
+	}
+	return sum / float64(len(values))
+}
+
+// Sums of the squared deviations of xs and ys, and of their products
+func deviations(xs []float64, ys []float64) (sxx float64, syy float64, sxy float64) {
+	mx, my := mean(xs), mean(ys)
+	for i := range xs {
+		dx, dy := xs[i]-mx, ys[i]-my
+		sxx += dx * dx
+		syy += dy * dy
+		sxy += dx * dy
+	}
+	return sxx, syy, sxy
+}
+
+/*
+ * Pearson's correlation coefficient of xs and ys. Reports false for fewer
+ * than two points or when either does not vary.
+ */
+func Pearson(xs []float64, ys []float64) (float64, bool) {
+	if len(xs) < 2 || len(xs) != len(ys) {
+		return 0, false
+	}
+	sxx, syy, sxy := deviations(xs, ys)
+	if sxx == 0 || syy == 0 {
+		return 0, false
+	}
+	return sxy / math.Sqrt(sxx*syy), true
+}
+
+// Ranks values from 1, giving tied values the average of their ranks
+func ranks(values []float64) []float64 {
+	order := make([]int, len(values))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Sort(byValue{order, values})
+
+	result := make([]float64, len(values))
+	for start := 0; start < len(order); {
+		end := start + 1
+		for end < len(order) && values[order[end]] == values[order[start]] {
+			end++
+		}
+		// positions start to end-1 hold ranks start+1 to end
+		rank := float64(start+1+end) / 2
+		for _, index := range order[start:end] {
+			result[index] = rank
+		}
+		start = end
+	}
+	return result
+}
+
+type byValue struct {
+	order  []int
+	values []float64
+}
+
+func (b byValue) Len() int           { return len(b.order) }
+func (b byValue) Swap(i, j int)      { b.order[i], b.order[j] = b.order[j], b.order[i] }
+func (b byValue) Less(i, j int) bool { return b.values[b.order[i]] < b.values[b.order[j]] }
+
+// Spearman's rank correlation coefficient of xs and ys, less swayed by outlying counties
+func Spearman(xs []float64, ys []float64) (float64, bool) {
+	if len(xs) != len(ys) {
+		return 0, false
+	}
+	return Pearson(ranks(xs), ranks(ys))
+}
+
+// Fits the least squares line through the points, false if xs does not vary
+func LinearRegression(xs []float64, ys []float64) (*model.Regression, bool) {
+	if len(xs) < 2 || len(xs) != len(ys) {
+		return nil, false
+	}
+	sxx, syy, sxy := deviations(xs, ys)
+	if sxx == 0 {
+		return nil, false
+	}
+	regression := &model.Regression{Slope: sxy / sxx}
+	regression.Intercept = mean(ys) - regression.Slope*mean(xs)
+	if syy > 0 {
+		regression.R2 = sxy * sxy / (sxx * syy)
+	}
+	return regression, true
+}
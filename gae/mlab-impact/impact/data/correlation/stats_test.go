@@ -0,0 +1,65 @@
+package correlation
+
+// Unit tests for the statistics comparing counties.
+
+import (
+	"math"
+	"testing"
+)
+
+func near(a float64, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+// Makes sure that Pearson finds perfect, inverse and no correlation and refuses constant data.
+func TestPearson(t *testing.T) {
+	tests := []struct {
+		xs       []float64
+		ys       []float64
+		expected float64
+		ok       bool
+	}{
+		{[]float64{1, 2, 3}, []float64{2, 4, 6}, 1, true},
+		{[]float64{1, 2, 3}, []float64{3, 2, 1}, -1, true},
+		{[]float64{1, 2, 3, 4}, []float64{1, -1, -1, 1}, 0, true},
+		{[]float64{1, 2, 3}, []float64{5, 5, 5}, 0, false},
+		{[]float64{1}, []float64{1}, 0, false},
+	}
+	for _, test := range tests {
+		value, ok := Pearson(test.xs, test.ys)
+		if ok != test.ok || (ok && !near(value, test.expected)) {
+			t.Errorf("TestPearson(%v, %v) got %v, %v, expected %v, %v", test.xs, test.ys, value, ok, test.expected, test.ok)
+		}
+	}
+}
+
+// Makes sure that tied values share the average of their ranks.
+func TestRanks(t *testing.T) {
+	ranked := ranks([]float64{30, 10, 20, 10})
+	expected := []float64{4, 1.5, 3, 1.5}
+	for i := range expected {
+		if ranked[i] != expected[i] {
+			t.Errorf("TestRanks got %v, expected %v", ranked, expected)
+			break
+		}
+	}
+}
+
+// Makes sure that Spearman sees any monotonic relation as perfect.
+func TestSpearman(t *testing.T) {
+	value, ok := Spearman([]float64{1, 2, 3, 4}, []float64{1, 8, 27, 1000})
+	if !ok || !near(value, 1) {
+		t.Errorf("TestSpearman got %v, %v", value, ok)
+	}
+}
+
+// Makes sure that the regression line and its fit are those of the points.
+func TestLinearRegression(t *testing.T) {
+	regression, ok := LinearRegression([]float64{0, 1, 2}, []float64{1, 3, 5})
+	if !ok || !near(regression.Slope, 2) || !near(regression.Intercept, 1) || !near(regression.R2, 1) {
+		t.Errorf("TestLinearRegression got %+v, %v", regression, ok)
+	}
+	if _, ok := LinearRegression([]float64{2, 2}, []float64{1, 3}); ok {
+		t.Errorf("TestLinearRegression fitted a line to constant xs")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"impact/data/model"
 	"impact/data/secrets"
 	"io/ioutil"
 	"net/http"
@@ -27,14 +28,15 @@ func DefaultGeolocator() *Geolocator {
 	}
 }
 
-func (geo *Geolocator) ByLatLong(r *http.Request, latitude float64, longitude float64) (map[string]interface{}, error) {
+func (geo *Geolocator) ByLatLong(r *http.Request, latitude float64, longitude float64) (*model.Location, error) {
 
 	parameters := fmt.Sprintf("latlng=%f,%f&sensor=%t",
 		latitude, longitude, geo.sensor)
 
-	result := make(map[string]interface{})
-	result["lat"] = latitude
-	result["lng"] = longitude
+	result := &model.Location{
+		Lat: latitude,
+		Lng: longitude,
+	}
 	err := geo.askGoogle(r, parameters, result)
 	if err != nil {
 		return nil, err
@@ -42,7 +44,7 @@ func (geo *Geolocator) ByLatLong(r *http.Request, latitude float64, longitude fl
 	return result, nil
 }
 
-func (geo *Geolocator) ByCityRegionCountry(r *http.Request, city string, region string, country string) (map[string]interface{}, error) {
+func (geo *Geolocator) ByCityRegionCountry(r *http.Request, city string, region string, country string) (*model.Location, error) {
 
 	parameters := "address="
 	if city != "" {
@@ -58,15 +60,10 @@ func (geo *Geolocator) ByCityRegionCountry(r *http.Request, city string, region
 	}
 	parameters += fmt.Sprintf("&sensor=%t", geo.sensor)
 
-	result := make(map[string]interface{})
-	if city != "" {
-		result["City"] = city
-	}
-	if region != "" {
-		result["State/Region"] = region
-	}
-	if country != "" {
-		result["Country"] = country
+	result := &model.Location{
+		City:    city,
+		Region:  region,
+		Country: country,
 	}
 
 	err := geo.askGoogle(r, parameters, result)
@@ -76,7 +73,7 @@ func (geo *Geolocator) ByCityRegionCountry(r *http.Request, city string, region
 	return result, nil
 }
 
-func (geo *Geolocator) askGoogle(r *http.Request, parameters string, result map[string]interface{}) error {
+func (geo *Geolocator) askGoogle(r *http.Request, parameters string, result *model.Location) error {
 	c := appengine.NewContext(r)
 	client := urlfetch.Client(c)
 	resp, err := client.Get(geo.url + parameters)
@@ -112,12 +109,9 @@ func (geo *Geolocator) askGoogle(r *http.Request, parameters string, result map[
 	possibleLocations := jsonVal["results"]
 	geo.parseResults(possibleLocations.([]interface{}), result)
 	return nil
-	result = make(map[string]interface{})
-	result["location"] = (jsonVal["results"].([]interface{}))[0]
-	return nil
 }
 
-func (geo *Geolocator) parseResults(jsonVal []interface{}, result map[string]interface{}) {
+func (geo *Geolocator) parseResults(jsonVal []interface{}, result *model.Location) {
 
 	for _, location := range jsonVal {
 		locationMap := location.(map[string]interface{})
@@ -136,43 +130,43 @@ func (geo *Geolocator) parseResults(jsonVal []interface{}, result map[string]int
 	}
 }
 
-func (geo *Geolocator) parseGeometry(jsonVal map[string]interface{}, result map[string]interface{}) {
+func (geo *Geolocator) parseGeometry(jsonVal map[string]interface{}, result *model.Location) {
 	location := jsonVal["location"]
 	if location != nil {
 		locationMap := location.(map[string]interface{})
-		if result["lat"] == nil && result["lng"] == nil &&
-			locationMap["lat"] != nil && locationMap["lng"] != nil {
-
-			result["lat"] = locationMap["lat"]
-			result["lng"] = locationMap["lng"]
+		lat, latOk := locationMap["lat"].(float64)
+		lng, lngOk := locationMap["lng"].(float64)
+		if result.Lat == 0 && result.Lng == 0 && latOk && lngOk {
+			result.Lat = lat
+			result.Lng = lng
 		}
 	}
 }
 
-func (geo *Geolocator) parseAddress(jsonVal []interface{}, result map[string]interface{}) {
+func (geo *Geolocator) parseAddress(jsonVal []interface{}, result *model.Location) {
 	for _, component := range jsonVal {
 		componentMap := component.(map[string]interface{})
 
-		label := ""
+		var label *string
 		typeStr := geo.getType(componentMap)
-		name := geo.getLongName(componentMap)
+		name, nameOk := geo.getLongName(componentMap).(string)
 
 		switch typeStr {
 		case "country":
-			label = "Country"
+			label = &result.Country
 		case "administrative_area_level_1":
-			label = "State/Region"
+			label = &result.Region
 		case "administrative_area_level_2":
-			label = "County"
+			label = &result.County
 		case "locality":
-			label = "City"
+			label = &result.City
 		case "postal_code":
-			label = "Zip"
+			label = &result.Zip
 		default:
-			label = ""
+			label = nil
 		}
-		if label != "" && result[label] == nil && name != nil {
-			result[label] = name
+		if label != nil && *label == "" && nameOk {
+			*label = name
 		}
 	}
 
@@ -0,0 +1,590 @@
+// Package defines the typed results that impact data sources fill in
+package model
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"time"
+)
+
+// Separator between the levels of a census variable label
+const LabelSeparator = " - "
+
+// Label segment of the variable counting every case of the level above it
+const TotalLabel = "Total"
+
+/*
+ * A geographic location either supplied by the client or resolved by the
+ * geolocator. Site (e.g. lga01) and Metro (e.g. lga) only apply to M-Lab
+ * server locations.
+ */
+type Location struct {
+	Lat     float64 `json:"lat,omitempty"`
+	Lng     float64 `json:"lng,omitempty"`
+	City    string  `json:"City,omitempty"`
+	County  string  `json:"County,omitempty"`
+	Region  string  `json:"State/Region,omitempty"`
+	Country string  `json:"Country,omitempty"`
+	Zip     string  `json:"Zip,omitempty"`
+	Site    string  `json:"Site,omitempty"`
+	Metro   string  `json:"Metro,omitempty"`
+	Error   string  `json:"Error,omitempty"`
+}
+
+/*
+ * A metric at a location and at each wider level containing it. Local is the
+ * most specific level that has a value; levels the location does not name,
+ * or that have no data, are left out.
+ */
+type ComparativeValues struct {
+	Local   float64
+	City    *float64 `json:",omitempty"`
+	Region  *float64 `json:",omitempty"`
+	Country *float64 `json:",omitempty"`
+	World   *float64 `json:",omitempty"`
+}
+
+/*
+ * A tree of census values keyed by the LabelSeparator separated segments of
+ * the variable labels. Marshals to the nested objects the front end expects,
+ * with the node's own value stored under "total", or "estimate" and "moe"
+ * for ACS estimates, and its share of its parent under "share".
+ */
+type CensusTable struct {
+	Value    *CensusValue
+	Estimate *Estimate
+	// Percentage the node's figure is of its parent's
+	Share    *float64
+	Children map[string]*CensusTable
+}
+
+/*
+ * A census count. When the Census Bureau published an annotation instead of
+ * a number, Null gives its reason and Total marshals as null.
+ */
+type CensusValue struct {
+	Total float64
+	Null  string
+}
+
+/*
+ * An ACS estimate with its 90 percent margin of error. MOE is nil when the
+ * Census Bureau publishes no margin, e.g. for too few sample cases. As with
+ * CensusValue, Null gives the reason an estimate was not published.
+ */
+type Estimate struct {
+	Estimate float64
+	MOE      *float64
+	Null     string
+}
+
+/*
+ * Adds up estimates the way the Census Bureau does: the margin of the sum is
+ * the root of the summed squares of the margins, counting the margins of zero
+ * estimates only once, through the largest of them. The sum has no margin if
+ * any estimate lacks one, and is null if any estimate is.
+ */
+func SumEstimates(estimates []*Estimate) *Estimate {
+	sum := &Estimate{}
+	squares, largestZero, known := 0.0, 0.0, true
+	for _, estimate := range estimates {
+		if estimate.Null != "" {
+			return &Estimate{Null: estimate.Null}
+		}
+		sum.Estimate += estimate.Estimate
+		if estimate.MOE == nil {
+			known = false
+		} else if estimate.Estimate == 0 {
+			largestZero = math.Max(largestZero, *estimate.MOE)
+		} else {
+			squares += *estimate.MOE * *estimate.MOE
+		}
+	}
+	if known {
+		moe := math.Sqrt(squares + largestZero*largestZero)
+		sum.MOE = &moe
+	}
+	return sum
+}
+
+func NewCensusTable() *CensusTable {
+	return &CensusTable{Children: make(map[string]*CensusTable)}
+}
+
+// Keys MarshalJSON writes the figures of a node under
+var figureKeys = map[string]bool{"total": true, "estimate": true, "moe": true, "share": true, "null": true}
+
+// Whether a level of label would be written under the same key as a figure
+func ReservedLabel(label string) bool {
+	for _, name := range strings.Split(label, LabelSeparator) {
+		if figureKeys[strings.TrimSpace(name)] {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+ * Returns the node for label, creating any missing levels along the way.
+ * Existing nodes are kept, so labels may be filled in any order.
+ */
+func (t *CensusTable) Space(label string) *CensusTable {
+	name, rest := label, ""
+	if index := strings.Index(label, LabelSeparator); index != -1 {
+		name, rest = label[:index], label[index+len(LabelSeparator):]
+	}
+	child := t.Children[name]
+	if child == nil {
+		child = NewCensusTable()
+		t.Children[name] = child
+	}
+	if rest == "" {
+		return child
+	}
+	return child.Space(rest)
+}
+
+/*
+ * Fills in the estimates of the levels below the root that no variable
+ * gave. A level with a TotalLabel child takes its estimate, as the total
+ * already counts its siblings, e.g. "Sex by Age by Citizenship Status"
+ * above "Total", "Male" and "Female". Other levels, e.g. "Income" above the
+ * income brackets, sum their children, and are left empty when a child
+ * lacks an estimate.
+ */
+func (t *CensusTable) SumMissing() {
+	for _, child := range t.Children {
+		child.sumMissing()
+	}
+}
+
+func (t *CensusTable) sumMissing() *Estimate {
+	estimates := make([]*Estimate, 0, len(t.Children))
+	for _, child := range t.Children {
+		if estimate := child.sumMissing(); estimate != nil {
+			estimates = append(estimates, estimate)
+		}
+	}
+	if t.Estimate != nil || t.Value != nil {
+		return t.Estimate
+	}
+	if total := t.Children[TotalLabel]; total != nil {
+		if total.Estimate != nil {
+			estimate := *total.Estimate
+			t.Estimate = &estimate
+		}
+	} else if len(estimates) > 0 && len(estimates) == len(t.Children) {
+		t.Estimate = SumEstimates(estimates)
+	}
+	return t.Estimate
+}
+
+// The node's count or estimate, false if it has none or it is null
+func (t *CensusTable) number() (float64, bool) {
+	if t.Value != nil && t.Value.Null == "" {
+		return t.Value.Total, true
+	}
+	if t.Estimate != nil && t.Estimate.Null == "" {
+		return t.Estimate.Estimate, true
+	}
+	return 0, false
+}
+
+// The node's count or estimate, else that of its TotalLabel child
+func (t *CensusTable) figure() (float64, bool) {
+	if value, ok := t.number(); ok {
+		return value, true
+	}
+	if total := t.Children[TotalLabel]; total != nil {
+		return total.number()
+	}
+	return 0, false
+}
+
+/*
+ * Gives each node below a node with a positive figure its percentage of
+ * that figure, e.g. "Male - Under 5 years" of "Male". A level without a
+ * figure of its own has its shares taken of its TotalLabel child, which
+ * gets none itself. The levels directly below the root have no parent
+ * figure and get no share.
+ */
+func (t *CensusTable) ComputeShares() {
+	parent, ok := t.figure()
+	for name, child := range t.Children {
+		child.Share = nil
+		if value, hasValue := child.number(); ok && hasValue && parent > 0 && name != TotalLabel {
+			share := value / parent * 100
+			child.Share = &share
+		}
+		child.ComputeShares()
+	}
+}
+
+func (t *CensusTable) MarshalJSON() ([]byte, error) {
+	obj := make(map[string]interface{}, len(t.Children)+4)
+	for name, child := range t.Children {
+		obj[name] = child
+	}
+	if t.Value != nil {
+		if t.Value.Null != "" {
+			obj["total"] = nil
+			obj["null"] = t.Value.Null
+		} else {
+			obj["total"] = t.Value.Total
+		}
+	}
+	if t.Estimate != nil {
+		if t.Estimate.Null != "" {
+			obj["estimate"] = nil
+			obj["null"] = t.Estimate.Null
+		} else {
+			obj["estimate"] = t.Estimate.Estimate
+		}
+		if t.Estimate.MOE != nil {
+			obj["moe"] = *t.Estimate.MOE
+		}
+	}
+	if t.Share != nil {
+		obj["share"] = *t.Share
+	}
+	return json.Marshal(obj)
+}
+
+/*
+ * Census tables for the client and server locations of a query, with the
+ * census geography each covers and the dataset, e.g. 2010/acs5, they were
+ * read from. A side whose figures could not be fetched has its error
+ * instead of a table.
+ */
+type CensusComparison struct {
+	Client          *CensusTable `json:"client,omitempty"`
+	Server          *CensusTable `json:"server,omitempty"`
+	ClientGeography string       `json:"clientGeography,omitempty"`
+	ServerGeography string       `json:"serverGeography,omitempty"`
+	ClientError     *SourceError `json:"clientError,omitempty"`
+	ServerError     *SourceError `json:"serverError,omitempty"`
+	Dataset         string       `json:"dataset,omitempty"`
+}
+
+// Whether both sides were fetched
+func (c *CensusComparison) Complete() bool {
+	return c.ClientError == nil && c.ServerError == nil
+}
+
+type FieldStats struct {
+	Average      float64       `json:"average"`
+	Stdev        float64       `json:"stdev"`
+	Distribution *Distribution `json:"distribution,omitempty"`
+}
+
+// Percentiles of a field with a histogram of its values
+type Distribution struct {
+	P10     float64   `json:"p10"`
+	Median  float64   `json:"median"`
+	P90     float64   `json:"p90"`
+	P99     float64   `json:"p99"`
+	Buckets []*Bucket `json:"buckets"`
+}
+
+// Values from Low up to High, holding Fraction of the tests
+type Bucket struct {
+	Low      float64 `json:"low"`
+	High     float64 `json:"high"`
+	Fraction float64 `json:"fraction"`
+}
+
+// A metric computed from the raw field statistics, with its unit
+type DerivedMetric struct {
+	Value       float64 `json:"value"`
+	Unit        string  `json:"unit"`
+	Description string  `json:"description"`
+}
+
+/*
+ * Network statistics computed from the NDT tests run between Start and End.
+ * Per field statistics and headline metrics are marshalled next to the job
+ * bookkeeping keys rather than nested; derived metrics are grouped under
+ * "derived".
+ */
+type NetworkData struct {
+	Complete   bool
+	JobID      string
+	Start      time.Time
+	End        time.Time
+	SampleSize int64
+	Fields     map[string]*FieldStats
+	Metrics    map[string]*ComparativeValues
+	Derived    map[string]*DerivedMetric
+	Trend      *Trend
+	Job        *JobHandle
+}
+
+func NewNetworkData() *NetworkData {
+	return &NetworkData{
+		Fields:  make(map[string]*FieldStats),
+		Metrics: make(map[string]*ComparativeValues),
+		Derived: make(map[string]*DerivedMetric),
+	}
+}
+
+func (n *NetworkData) MarshalJSON() ([]byte, error) {
+	obj := make(map[string]interface{}, len(n.Fields)+len(n.Metrics)+3)
+	for name, stats := range n.Fields {
+		obj[name] = stats
+	}
+	for name, values := range n.Metrics {
+		obj[name] = values
+	}
+	obj["complete"] = n.Complete
+	if !n.Start.IsZero() {
+		obj["start"] = n.Start
+		obj["end"] = n.End
+	}
+	if n.JobID != "" {
+		obj["jobID"] = n.JobID
+	}
+	if n.Job != nil {
+		obj["job"] = n.Job
+	}
+	if n.Complete {
+		obj["sample size"] = n.SampleSize
+	}
+	if len(n.Derived) > 0 {
+		obj["derived"] = n.Derived
+	}
+	if n.Trend != nil {
+		obj["trend"] = n.Trend
+	}
+	return json.Marshal(obj)
+}
+
+// Headline metrics of the tests run in the period beginning at Start
+type TrendPoint struct {
+	Start      time.Time          `json:"start"`
+	SampleSize int64              `json:"sample size"`
+	Metrics    map[string]float64 `json:"metrics"`
+}
+
+// Headline metrics per month or week, oldest first
+type Trend struct {
+	Period   string        `json:"period"`
+	Complete bool          `json:"complete"`
+	JobID    string        `json:"jobID,omitempty"`
+	Job      *JobHandle    `json:"job,omitempty"`
+	Points   []*TrendPoint `json:"points"`
+}
+
+func NewTrend(period string) *Trend {
+	return &Trend{Period: period, Points: []*TrendPoint{}}
+}
+
+// Kinds of query a BigQuery job can run
+const (
+	JobData  = "data"
+	JobTrend = "trend"
+)
+
+/*
+ * States a job reports, as BigQuery names them plus JobCancelled, and
+ * JobUnknown until BigQuery has been asked.
+ */
+const (
+	JobUnknown   = "UNKNOWN"
+	JobPending   = "PENDING"
+	JobRunning   = "RUNNING"
+	JobDone      = "DONE"
+	JobCancelled = "CANCELLED"
+)
+
+/*
+ * A BigQuery job that did not finish within the query that submitted it,
+ * with what is needed to read its rows back: the fields it selected and
+ * those it computed distributions of for JobData jobs, the period of
+ * JobTrend jobs, and the locations and time range it covers. Owner is the ID
+ * of the signed in user who submitted it, empty for anonymous queries.
+ */
+type JobHandle struct {
+	ID            string    `json:"id"`
+	Kind          string    `json:"kind"`
+	Period        string    `json:"period,omitempty"`
+	Fields        []string  `json:"fields,omitempty"`
+	Distributions []string  `json:"distributions,omitempty"`
+	Client        *Location `json:"client,omitempty"`
+	Server        *Location `json:"server,omitempty"`
+	Start         time.Time `json:"start"`
+	End           time.Time `json:"end"`
+	Submitted     time.Time `json:"submitted"`
+	Cancelled     bool      `json:"cancelled,omitempty"`
+	Owner         string    `json:"-"`
+}
+
+// Progress of a job. Started and Finished are set once BigQuery reports them.
+type JobStatus struct {
+	Job            *JobHandle `json:"job"`
+	State          string     `json:"state"`
+	Error          string     `json:"error,omitempty"`
+	BytesProcessed int64      `json:"bytes processed,omitempty"`
+	Started        *time.Time `json:"started,omitempty"`
+	Finished       *time.Time `json:"finished,omitempty"`
+}
+
+/*
+ * One page of the rows of a job, parsed into Network for JobData jobs or
+ * Trend for JobTrend jobs. NextIndex is the start of the following page and
+ * is left out on the last one.
+ */
+type JobPage struct {
+	Job        *JobHandle   `json:"job"`
+	Complete   bool         `json:"complete"`
+	StartIndex uint64       `json:"startIndex"`
+	NextIndex  uint64       `json:"nextIndex,omitempty"`
+	TotalRows  uint64       `json:"totalRows"`
+	Network    *NetworkData `json:"network data,omitempty"`
+	Trend      *Trend       `json:"trend,omitempty"`
+}
+
+// A county in a correlation: its census figure X against a network metric Y over Tests tests
+type CorrelationPoint struct {
+	County string  `json:"county"`
+	FIPS   string  `json:"fips"`
+	X      float64 `json:"x"`
+	Y      float64 `json:"y"`
+	Tests  int64   `json:"tests"`
+}
+
+// The least squares line Y = Slope * X + Intercept, and the share R2 of the variance it explains
+type Regression struct {
+	Slope     float64 `json:"slope"`
+	Intercept float64 `json:"intercept"`
+	R2        float64 `json:"r2"`
+}
+
+/*
+ * How a network metric varies with a census variable across counties. The
+ * coefficients and line are left out when too few counties vary.
+ */
+type MetricCorrelation struct {
+	Metric     string              `json:"metric"`
+	Unit       string              `json:"unit"`
+	Pearson    *float64            `json:"pearson,omitempty"`
+	Spearman   *float64            `json:"spearman,omitempty"`
+	Regression *Regression         `json:"regression,omitempty"`
+	Points     []*CorrelationPoint `json:"points"`
+}
+
+/*
+ * The network metrics of the counties of a state against one of their
+ * census variables. Skipped gives, by county, why a county is not among
+ * the points, e.g. too few tests.
+ */
+type Correlation struct {
+	State    string               `json:"state"`
+	Variable string               `json:"variable"`
+	Label    string               `json:"label"`
+	Dataset  string               `json:"dataset"`
+	Start    time.Time            `json:"start"`
+	End      time.Time            `json:"end"`
+	Metrics  []*MetricCorrelation `json:"metrics"`
+	Skipped  map[string]string    `json:"skipped,omitempty"`
+}
+
+// Machine readable codes for why a source did not contribute to a result
+const (
+	ErrCodeTimeout        = "timeout"
+	ErrCodeUnavailable    = "unavailable"
+	ErrCodeFailed         = "failed"
+	ErrCodeUnknownSource  = "unknown_source"
+	ErrCodeDisabled       = "disabled"
+	ErrCodeInvalidRequest = "invalid_request"
+	ErrCodeNotFound       = "not_found"
+	ErrCodeCancelled      = "cancelled"
+	ErrCodeForbidden      = "forbidden"
+)
+
+// The failure of a single source, reported under the source's name
+type SourceError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func NewSourceError(code string, err error) *SourceError {
+	return &SourceError{Code: code, Message: err.Error()}
+}
+
+func (e *SourceError) Error() string {
+	return e.Message
+}
+
+// The combined result of a query across all sources
+type Result struct {
+	ACS     *CensusComparison `json:"ACS,omitempty"`
+	SF1     *CensusComparison `json:"SF1,omitempty"`
+	Network *NetworkData      `json:"network data,omitempty"`
+	Client  *Location         `json:"client,omitempty"`
+	Server  *Location         `json:"server,omitempty"`
+	Err     string            `json:"err,omitempty"`
+	Partial bool              `json:"partial,omitempty"`
+
+	Errors map[string]*SourceError `json:"errors,omitempty"`
+	// Whether each source was answered from the cache, sent as headers
+	Cache map[string]string `json:"-"`
+}
+
+// Records why the named source is missing from the result
+func (r *Result) AddError(source string, err error) {
+	sourceErr, ok := err.(*SourceError)
+	if !ok {
+		sourceErr = NewSourceError(ErrCodeFailed, err)
+	}
+	if r.Errors == nil {
+		r.Errors = make(map[string]*SourceError)
+	}
+	r.Errors[source] = sourceErr
+	r.Partial = true
+}
+
+// Records whether the named source was answered from the cache
+func (r *Result) SetCacheStatus(source string, status string) {
+	if r.Cache == nil {
+		r.Cache = make(map[string]string)
+	}
+	r.Cache[source] = status
+}
+
+// Copies the parts of other that are not yet set in r
+func (r *Result) Merge(other *Result) *Result {
+	if other == nil {
+		return r
+	}
+	if r.ACS == nil {
+		r.ACS = other.ACS
+	}
+	if r.SF1 == nil {
+		r.SF1 = other.SF1
+	}
+	if r.Network == nil {
+		r.Network = other.Network
+	}
+	if r.Client == nil {
+		r.Client = other.Client
+	}
+	if r.Server == nil {
+		r.Server = other.Server
+	}
+	if r.Err == "" {
+		r.Err = other.Err
+	}
+	r.Partial = r.Partial || other.Partial
+	for source, err := range other.Errors {
+		if r.Errors[source] == nil {
+			r.AddError(source, err)
+		}
+	}
+	for source, status := range other.Cache {
+		if r.Cache[source] == "" {
+			r.SetCacheStatus(source, status)
+		}
+	}
+	return r
+}
@@ -0,0 +1,219 @@
+package model
+
+// Unit tests for the JSON shape of the result model.
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+// Makes sure that nested labels marshal to nested objects with a total.
+func TestCensusTableJSON(t *testing.T) {
+	table := NewCensusTable()
+	table.Space("Male:").Value = &CensusValue{Total: 10}
+	table.Space("Male: - Under 5 years").Value = &CensusValue{Total: 3}
+
+	b, err := json.Marshal(table)
+	if err != nil {
+		t.Fatalf("TestCensusTableJSON:json.Marshal err = %v", err)
+	}
+	expected := `{"Male:":{"Under 5 years":{"total":3},"total":10}}`
+	if string(b) != expected {
+		t.Errorf("TestCensusTableJSON got %s, expected %s", b, expected)
+	}
+}
+
+func moe(value float64) *float64 {
+	return &value
+}
+
+// Makes sure that filling a level keeps the levels below it.
+func TestCensusTableSpaceOrder(t *testing.T) {
+	table := NewCensusTable()
+	table.Space("Male: - Under 5 years").Value = &CensusValue{Total: 3}
+	table.Space("Male:").Value = &CensusValue{Total: 10}
+	if male := table.Children["Male:"]; male.Value.Total != 10 || male.Children["Under 5 years"] == nil {
+		t.Errorf("TestCensusTableSpaceOrder lost a level, got %+v", male)
+	}
+}
+
+// Makes sure that estimates marshal with their margins of error.
+func TestEstimateJSON(t *testing.T) {
+	table := NewCensusTable()
+	table.Space("Income - Less than $10,000").Estimate = &Estimate{Estimate: 120, MOE: moe(15)}
+	table.Space("Income - $10,000 to $14,999").Estimate = &Estimate{Estimate: 80}
+
+	b, err := json.Marshal(table)
+	if err != nil {
+		t.Fatalf("TestEstimateJSON:json.Marshal err = %v", err)
+	}
+	expected := `{"Income":{"$10,000 to $14,999":{"estimate":80},"Less than $10,000":{"estimate":120,"moe":15}}}`
+	if string(b) != expected {
+		t.Errorf("TestEstimateJSON got %s, expected %s", b, expected)
+	}
+}
+
+// Makes sure that sums follow the Census Bureau's margin of error formula.
+func TestSumEstimates(t *testing.T) {
+	sum := SumEstimates([]*Estimate{
+		{Estimate: 100, MOE: moe(30)},
+		{Estimate: 50, MOE: moe(40)},
+		// zero estimates count their largest margin once
+		{Estimate: 0, MOE: moe(12)},
+		{Estimate: 0, MOE: moe(20)},
+	})
+	// sqrt(30^2 + 40^2 + 20^2)
+	if sum.Estimate != 150 || sum.MOE == nil || *sum.MOE != math.Sqrt(2900) {
+		t.Errorf("TestSumEstimates got %v, %v", sum.Estimate, sum.MOE)
+	}
+
+	if sum := SumEstimates([]*Estimate{{Estimate: 1, MOE: moe(1)}, {Estimate: 2}}); sum.MOE != nil {
+		t.Errorf("TestSumEstimates gave a margin to a sum with an unknown one")
+	}
+}
+
+// Makes sure that levels without a variable get the sum of their children.
+func TestSumMissing(t *testing.T) {
+	table := NewCensusTable()
+	table.Space("Income - Less than $10,000").Estimate = &Estimate{Estimate: 30, MOE: moe(3)}
+	table.Space("Income - $10,000 to $14,999").Estimate = &Estimate{Estimate: 10, MOE: moe(4)}
+	table.Space("Citizenship - Total").Estimate = &Estimate{Estimate: 5, MOE: moe(1)}
+	table.Space("Citizenship - Male").Estimate = &Estimate{Estimate: 2, MOE: moe(1)}
+	table.Space("Age - Under 5 years").Estimate = &Estimate{Estimate: 5, MOE: moe(1)}
+	table.Space("Age - 5 to 9 years").Value = &CensusValue{Total: 2}
+	table.SumMissing()
+
+	income := table.Children["Income"].Estimate
+	if income == nil || income.Estimate != 40 || *income.MOE != 5 {
+		t.Errorf("TestSumMissing Income got %+v", income)
+	}
+	citizenship := table.Children["Citizenship"].Estimate
+	if citizenship == nil || citizenship.Estimate != 5 || *citizenship.MOE != 1 {
+		t.Errorf("TestSumMissing took %+v rather than the total", citizenship)
+	}
+	if table.Estimate != nil || table.Children["Age"].Estimate != nil {
+		t.Errorf("TestSumMissing summed the root or a level with a child lacking an estimate")
+	}
+}
+
+// Makes sure that annotated figures marshal as null with their reason.
+func TestCensusNullJSON(t *testing.T) {
+	table := NewCensusTable()
+	table.Space("Total").Value = &CensusValue{Null: "not applicable or not available"}
+	table.Space("Income").Estimate = &Estimate{Null: "too few sample cases to display"}
+
+	b, err := json.Marshal(table)
+	if err != nil {
+		t.Fatalf("TestCensusNullJSON:json.Marshal err = %v", err)
+	}
+	expected := `{"Income":{"estimate":null,"null":"too few sample cases to display"},` +
+		`"Total":{"null":"not applicable or not available","total":null}}`
+	if string(b) != expected {
+		t.Errorf("TestCensusNullJSON got %s, expected %s", b, expected)
+	}
+}
+
+// Makes sure that figures get their share of the level above.
+func TestComputeShares(t *testing.T) {
+	table := NewCensusTable()
+	table.Space("Male:").Value = &CensusValue{Total: 200}
+	table.Space("Male: - Under 5 years").Value = &CensusValue{Total: 50}
+	table.Space("Male: - Under 5 years - Born here").Value = &CensusValue{Total: 10}
+	table.Space("Male: - 5 to 9 years").Value = &CensusValue{Null: "not applicable or not available"}
+	table.Space("Female:").Value = &CensusValue{Total: 0}
+	table.Space("Female: - Under 5 years").Value = &CensusValue{Total: 0}
+	table.ComputeShares()
+
+	male := table.Children["Male:"]
+	if male.Share != nil {
+		t.Errorf("TestComputeShares gave a share to a level without a parent figure")
+	}
+	under5 := male.Children["Under 5 years"]
+	if under5.Share == nil || *under5.Share != 25 {
+		t.Errorf("TestComputeShares Under 5 years got %v", under5.Share)
+	}
+	if share := under5.Children["Born here"].Share; share == nil || *share != 20 {
+		t.Errorf("TestComputeShares Born here got %v", share)
+	}
+	if male.Children["5 to 9 years"].Share != nil || table.Children["Female:"].Children["Under 5 years"].Share != nil {
+		t.Errorf("TestComputeShares gave a share to a null figure or of a zero total")
+	}
+}
+
+// Makes sure that a level without a figure of its own has shares taken of its Total.
+func TestComputeSharesOfTotal(t *testing.T) {
+	table := NewCensusTable()
+	table.Space("Households - Total").Value = &CensusValue{Total: 80}
+	table.Space("Households - Family households").Value = &CensusValue{Total: 60}
+	table.ComputeShares()
+
+	households := table.Children["Households"]
+	if share := households.Children["Family households"].Share; share == nil || *share != 75 {
+		t.Errorf("TestComputeSharesOfTotal Family households got %v", share)
+	}
+	if households.Children["Total"].Share != nil {
+		t.Errorf("TestComputeSharesOfTotal gave the total a share")
+	}
+}
+
+// Makes sure that field statistics sit next to the bookkeeping keys.
+func TestNetworkDataJSON(t *testing.T) {
+	network := NewNetworkData()
+	network.Complete = true
+	network.SampleSize = 4
+	network.Fields["MinRTT"] = &FieldStats{Average: 1.5, Stdev: 0.5}
+
+	b, err := json.Marshal(network)
+	if err != nil {
+		t.Fatalf("TestNetworkDataJSON:json.Marshal err = %v", err)
+	}
+	expected := `{"MinRTT":{"average":1.5,"stdev":0.5},"complete":true,"sample size":4}`
+	if string(b) != expected {
+		t.Errorf("TestNetworkDataJSON got %s, expected %s", b, expected)
+	}
+
+	pending := NewNetworkData()
+	pending.JobID = "job_1"
+	b, err = json.Marshal(pending)
+	if err != nil {
+		t.Fatalf("TestNetworkDataJSON:json.Marshal err = %v", err)
+	}
+	expected = `{"complete":false,"jobID":"job_1"}`
+	if string(b) != expected {
+		t.Errorf("TestNetworkDataJSON got %s, expected %s", b, expected)
+	}
+}
+
+// Makes sure that Merge keeps values that are already set.
+func TestResultMerge(t *testing.T) {
+	first := &Result{ACS: &CensusComparison{}}
+	second := &Result{ACS: &CensusComparison{}, Network: NewNetworkData()}
+
+	acs := first.ACS
+	first.Merge(second)
+	if first.ACS != acs {
+		t.Fail()
+	}
+	if first.Network != second.Network {
+		t.Fail()
+	}
+	first.Merge(nil)
+	if first.Network != second.Network {
+		t.Fail()
+	}
+}
+
+// Makes sure that labels are reserved when any of their levels names a figure.
+func TestReservedLabel(t *testing.T) {
+	for _, label := range []string{"total", "Income - share", "null - Durham"} {
+		if !ReservedLabel(label) {
+			t.Errorf("TestReservedLabel allowed %q", label)
+		}
+	}
+	for _, label := range []string{"Total", "Median household income", "Share of renters"} {
+		if ReservedLabel(label) {
+			t.Errorf("TestReservedLabel refused %q", label)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package ndt
+
+import (
+	"code.google.com/p/google-api-go-client/bigquery/v2"
+	"errors"
+	"impact/data/bqsql"
+	"impact/data/model"
+	"net/http"
+	"time"
+)
+
+var (
+	errNoRegion      = errors.New("City tests are only summed up within a region")
+	errQueryDeadline = errors.New("BigQuery did not finish the query before its deadline")
+)
+
+var (
+	clientLatField = bqsql.Field("connection_spec.client_geolocation.latitude")
+	clientLngField = bqsql.Field("connection_spec.client_geolocation.longitude")
+)
+
+/*
+ * The tests run from a place, with the sums and counts of the metricFields
+ * so that places add up exactly. Lat and Lng average the client locations.
+ */
+type TestSums struct {
+	Place  string
+	Lat    float64
+	Lng    float64
+	Tests  int64
+	Sums   map[string]float64
+	Counts map[string]int64
+}
+
+func NewTestSums(place string) *TestSums {
+	return &TestSums{
+		Place:  place,
+		Sums:   make(map[string]float64),
+		Counts: make(map[string]int64),
+	}
+}
+
+// Adds the tests of other, moving Lat and Lng to the average of both
+func (t *TestSums) Add(other *TestSums) {
+	if total := t.Tests + other.Tests; total > 0 {
+		t.Lat = (t.Lat*float64(t.Tests) + other.Lat*float64(other.Tests)) / float64(total)
+		t.Lng = (t.Lng*float64(t.Tests) + other.Lng*float64(other.Tests)) / float64(total)
+	}
+	t.Tests += other.Tests
+	for field, sum := range other.Sums {
+		t.Sums[field] += sum
+		t.Counts[field] += other.Counts[field]
+	}
+}
+
+// The headline metrics of the tests, as computeMetrics gives them
+func (t *TestSums) Metrics() map[string]float64 {
+	averages := make(map[string]float64)
+	for field, sum := range t.Sums {
+		if count := t.Counts[field]; count > 0 {
+			averages[field] = sum / float64(count)
+		}
+	}
+	return computeMetrics(averages)
+}
+
+/*
+ * Selects, per client city of region, the average client coordinates, the
+ * test count and the sum and count of each of the metricFields.
+ * parseCityRows reads them back in that order.
+ */
+func (ndt *NDT) getCityQuery(timeRange *TimeRange, region *model.Location) string {
+	columns := []bqsql.Expr{clientCityField,
+		bqsql.Func("AVG", clientLatField), bqsql.Func("AVG", clientLngField), bqsql.Count()}
+	for _, field := range metricFields {
+		columns = append(columns, bqsql.Func("SUM", snapField(field)), bqsql.Func("COUNT", snapField(field)))
+	}
+	return bqsql.Select(columns...).
+		From(ndt.getQueryTables(timeRange)...).
+		Where(timeRange.condition(), ndt.getLevelCondition(region, regionLevel)).
+		GroupBy(clientCityField).
+		String()
+}
+
+func parseCityRows(rows []*bigquery.TableRow) []*TestSums {
+	cities := []*TestSums{}
+	for _, row := range rows {
+		city := NewTestSums(row.F[0].V)
+		city.Lat = cellFloat(row.F[1])
+		city.Lng = cellFloat(row.F[2])
+		city.Tests = int64(cellFloat(row.F[3]))
+		pos := 4
+		for _, field := range metricFields {
+			city.Sums[field] = cellFloat(row.F[pos])
+			city.Counts[field] = int64(cellFloat(row.F[pos+1]))
+			pos += 2
+		}
+		cities = append(cities, city)
+	}
+	return cities
+}
+
+/*
+ * Sums up the tests run from each city of the region loc names, for
+ * relating them to the demographics of the places around them. Waits for
+ * BigQuery until deadline, failing with ErrCodeTimeout after it.
+ */
+func (ndt *NDT) CityTests(r *http.Request, timeRange *TimeRange, loc *model.Location, deadline time.Time) ([]*TestSums, error) {
+	if loc.Region == "" {
+		return nil, model.NewSourceError(model.ErrCodeInvalidRequest, errNoRegion)
+	}
+	cities := []*TestSums{}
+	err := ndt.readAllRows(r, ndt.getCityQuery(timeRange, loc), deadline, func(rows []*bigquery.TableRow) {
+		cities = append(cities, parseCityRows(rows)...)
+	})
+	if err == errQueryDeadline {
+		return nil, model.NewSourceError(model.ErrCodeTimeout, err)
+	}
+	if err != nil {
+		return nil, model.NewSourceError(model.ErrCodeUnavailable, err)
+	}
+	return cities, nil
+}
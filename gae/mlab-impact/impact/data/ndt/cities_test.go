@@ -0,0 +1,57 @@
+package ndt
+
+// Unit tests for summing up the tests of each city.
+
+import (
+	"code.google.com/p/google-api-go-client/bigquery/v2"
+	"impact/data/model"
+	"strings"
+	"testing"
+)
+
+// Builds a city query row; each metric field is summed over every test.
+func testCityRow(city string, lat float64, lng float64, tests float64, octets float64, duration float64, rtt float64) *bigquery.TableRow {
+	row := testRow(lat, lng, tests,
+		octets, tests, duration, tests, rtt, tests, tests, tests, 0, tests, 100*tests, tests)[0]
+	row.F = append([]*bigquery.TableRowF{{V: city}}, row.F...)
+	return row
+}
+
+// Makes sure that city rows are read back and add up into wider places.
+func TestParseCityRows(t *testing.T) {
+	cities := parseCityRows([]*bigquery.TableRow{
+		testCityRow("Durham", 36.0, -78.9, 30, 30e6, 30e6, 3000),
+		testCityRow("Cary", 35.8, -78.8, 10, 30e6, 10e6, 2000),
+	})
+	if len(cities) != 2 || cities[0].Place != "Durham" || cities[0].Tests != 30 || cities[1].Counts["SumRTT"] != 10 {
+		t.Fatalf("TestParseCityRows got %+v", cities)
+	}
+
+	county := NewTestSums("Durham County")
+	county.Add(cities[0])
+	county.Add(cities[1])
+	if county.Tests != 40 || county.Lat != 35.95 {
+		t.Errorf("TestParseCityRows county got %v tests at %v", county.Tests, county.Lat)
+	}
+	// 60e6 octets * 8 over 40e6 microseconds, 5000 ms of RTT over 40 samples
+	metrics := county.Metrics()
+	if metrics[ThroughputMetric] != 12 || metrics[RTTMetric] != 125 {
+		t.Errorf("TestParseCityRows metrics got %v", metrics)
+	}
+}
+
+// Makes sure that the city query is limited to the region and grouped by city.
+func TestGetCityQuery(t *testing.T) {
+	ndt := NDT_Source()
+	query := ndt.getCityQuery(testJobRange, &model.Location{Country: "United States", Region: "North Carolina"})
+	for _, part := range []string{
+		`connection_spec.client_geolocation.region="North Carolina"`,
+		"AVG(connection_spec.client_geolocation.latitude)",
+		"SUM(web100_log_entry.snap.DataOctetsOut)",
+		"GROUP BY connection_spec.client_geolocation.city",
+	} {
+		if !strings.Contains(query, part) {
+			t.Errorf("TestGetCityQuery %v is missing %v", query, part)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package ndt
+
+import (
+	"impact/data/model"
+)
+
+/*
+ * A metric derived from the averages of the web100 fields, for users who do
+ * not read DataOctetsOut or CongSignals. compute reports false when the
+ * fields it needs are missing or zero.
+ */
+type derivedMetric struct {
+	name        string
+	unit        string
+	description string
+	compute     func(averages map[string]float64) (float64, bool)
+}
+
+// Metrics derived from the local averages of DefaultFields
+var derivedMetrics = []*derivedMetric{
+	{
+		name:        "Download Throughput",
+		unit:        "Mbit/s",
+		description: "Data sent by the server per second of the test",
+		compute:     throughput,
+	},
+	{
+		name:        "Retransmission Ratio",
+		unit:        "ratio",
+		description: "Share of data segments the server had to send again",
+		compute: func(averages map[string]float64) (float64, bool) {
+			return ratio(averages["PktsRetrans"], averages["DataSegsOut"])
+		},
+	},
+	{
+		name:        "Loss Ratio",
+		unit:        "ratio",
+		description: "Congestion signals per data segment sent",
+		compute:     loss,
+	},
+	{
+		name:        "Congestion Limited Ratio",
+		unit:        "ratio",
+		description: "Share of the test the server was held back by network congestion",
+		compute: func(averages map[string]float64) (float64, bool) {
+			return sendLimitedRatio(averages, "SndLimTimeCwnd")
+		},
+	},
+	{
+		name:        "Receiver Limited Ratio",
+		unit:        "ratio",
+		description: "Share of the test the server was held back by the client's receive window",
+		compute: func(averages map[string]float64) (float64, bool) {
+			return sendLimitedRatio(averages, "SndLimTimeRwin")
+		},
+	},
+	{
+		name:        "Round Trip Time",
+		unit:        "ms",
+		description: "Average time for a segment to be acknowledged",
+		compute:     rtt,
+	},
+}
+
+// Share of the time spent sending that was limited by the given SndLimTime field
+func sendLimitedRatio(averages map[string]float64, field string) (float64, bool) {
+	total := averages["SndLimTimeRwin"] + averages["SndLimTimeCwnd"] + averages["SndLimTimeSnd"]
+	return ratio(averages[field], total)
+}
+
+// Computes every derived metric whose fields are in stats
+func computeDerived(stats map[string]*model.FieldStats) map[string]*model.DerivedMetric {
+	averages := make(map[string]float64, len(stats))
+	for name, fieldStats := range stats {
+		averages[name] = fieldStats.Average
+	}
+
+	derived := make(map[string]*model.DerivedMetric)
+	for _, metric := range derivedMetrics {
+		if value, ok := metric.compute(averages); ok {
+			derived[metric.name] = &model.DerivedMetric{
+				Value:       value,
+				Unit:        metric.unit,
+				Description: metric.description,
+			}
+		}
+	}
+	return derived
+}
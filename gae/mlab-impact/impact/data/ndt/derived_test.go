@@ -0,0 +1,60 @@
+package ndt
+
+// Unit tests for the metrics derived from the web100 fields.
+
+import (
+	"impact/data/model"
+	"testing"
+)
+
+// Makes sure that the derived metrics come out in their documented units.
+func TestComputeDerived(t *testing.T) {
+	averages := map[string]float64{
+		"DataOctetsOut":  12500000,
+		"Duration":       10000000,
+		"SumRTT":         900,
+		"CountRTT":       30,
+		"CongSignals":    2,
+		"DataSegsOut":    400,
+		"PktsRetrans":    8,
+		"SndLimTimeRwin": 2000,
+		"SndLimTimeCwnd": 6000,
+		"SndLimTimeSnd":  2000,
+	}
+	stats := make(map[string]*model.FieldStats)
+	for name, average := range averages {
+		stats[name] = &model.FieldStats{Average: average}
+	}
+
+	expected := map[string]float64{
+		"Download Throughput":      10,
+		"Retransmission Ratio":     0.02,
+		"Loss Ratio":               0.005,
+		"Congestion Limited Ratio": 0.6,
+		"Receiver Limited Ratio":   0.2,
+		"Round Trip Time":          30,
+	}
+	derived := computeDerived(stats)
+	if len(derived) != len(expected) {
+		t.Errorf("TestComputeDerived computed %v metrics", len(derived))
+	}
+	for name, value := range expected {
+		if derived[name] == nil || derived[name].Value != value {
+			t.Errorf("TestComputeDerived %v = %+v, expected %v", name, derived[name], value)
+		}
+	}
+	if derived["Round Trip Time"] != nil && derived["Round Trip Time"].Unit != "ms" {
+		t.Errorf("TestComputeDerived RTT unit = %v", derived["Round Trip Time"].Unit)
+	}
+}
+
+// Makes sure that metrics are left out when their fields were not queried.
+func TestComputeDerivedMissingFields(t *testing.T) {
+	derived := computeDerived(map[string]*model.FieldStats{
+		"SumRTT":   &model.FieldStats{Average: 900},
+		"CountRTT": &model.FieldStats{Average: 30},
+	})
+	if len(derived) != 1 || derived["Round Trip Time"] == nil {
+		t.Errorf("TestComputeDerivedMissingFields computed %v", derived)
+	}
+}
@@ -0,0 +1,116 @@
+package ndt
+
+import (
+	"code.google.com/p/google-api-go-client/bigquery/v2"
+	"fmt"
+	"impact/data/bqsql"
+	"impact/data/model"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Request parameter naming, comma separated, the fields to compute distributions of
+const DistributionsParameter = "distributions"
+
+// Value of DistributionsParameter asking for no distributions, e.g. to be answered from the index
+const NoDistributions = "none"
+
+/*
+ * Fields distributions are computed for unless a request names others: those
+ * the headline metrics come from. Each distribution takes a QUANTILES
+ * aggregate per percentile, too many to compute for every field.
+ */
+var DefaultDistributionFields = metricFields
+
+// Most fields a request may ask distributions of
+const MaxDistributionFields = 8
+
+/*
+ * Quantiles are read with NTH(n, QUANTILES(field, 101)), where n-1 is the
+ * percentile. The deciles double as the edges of an equal frequency
+ * histogram and are followed by the 99th percentile.
+ */
+const quantileCount = 101
+
+var distributionPercentiles = []int64{0, 10, 20, 30, 40, 50, 60, 70, 80, 90, 100, 99}
+
+// Columns getDistributionFields adds for each field
+var distributionColumns = len(distributionPercentiles)
+
+func getDistributionFields(expr bqsql.Expr) []bqsql.Expr {
+	quantiles := bqsql.Func("QUANTILES", expr, bqsql.Int(quantileCount))
+	columns := make([]bqsql.Expr, len(distributionPercentiles))
+	for i, percentile := range distributionPercentiles {
+		columns[i] = bqsql.Func("NTH", bqsql.Int(percentile+1), quantiles)
+	}
+	return columns
+}
+
+/*
+ * Reads the fields a request asks distributions of, sorted and without
+ * repeats, or DefaultDistributionFields when it names none, and no fields
+ * for NoDistributions. Only fields of DefaultFields can be asked for.
+ */
+func ParseDistributions(r *http.Request) ([]string, error) {
+	if strings.TrimSpace(r.FormValue(DistributionsParameter)) == NoDistributions {
+		return []string{}, nil
+	}
+	known := make(map[string]bool, len(DefaultFields))
+	for _, field := range DefaultFields {
+		known[field] = true
+	}
+	seen := make(map[string]bool)
+	fields := []string{}
+	for _, field := range strings.Split(r.FormValue(DistributionsParameter), ",") {
+		field = strings.TrimSpace(field)
+		if field == "" || seen[field] {
+			continue
+		}
+		if !known[field] {
+			return nil, fmt.Errorf("Unknown NDT field %q", field)
+		}
+		seen[field] = true
+		fields = append(fields, field)
+	}
+	if len(fields) == 0 {
+		return DefaultDistributionFields, nil
+	}
+	if len(fields) > MaxDistributionFields {
+		return nil, fmt.Errorf("At most %v distributions can be asked for, got %v", MaxDistributionFields, len(fields))
+	}
+	sort.Strings(fields)
+	return fields, nil
+}
+
+func fieldSet(fields []string) map[string]bool {
+	set := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		set[field] = true
+	}
+	return set
+}
+
+// Reads back the columns of getDistributionFields
+func parseDistribution(cells []*bigquery.TableRowF) *model.Distribution {
+	percentiles := make(map[int64]float64)
+	for i, percentile := range distributionPercentiles {
+		percentiles[percentile] = cellFloat(cells[i])
+	}
+
+	distribution := &model.Distribution{
+		P10:     percentiles[10],
+		Median:  percentiles[50],
+		P90:     percentiles[90],
+		P99:     percentiles[99],
+		Buckets: []*model.Bucket{},
+	}
+	for decile := int64(0); decile < 100; decile += 10 {
+		distribution.Buckets = append(distribution.Buckets, &model.Bucket{
+			Low:      percentiles[decile],
+			High:     percentiles[decile+10],
+			Fraction: 0.1,
+		})
+	}
+	return distribution
+}
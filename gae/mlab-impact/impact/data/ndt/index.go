@@ -0,0 +1,398 @@
+package ndt
+
+import (
+	"appengine"
+	"appengine/datastore"
+	"code.google.com/p/google-api-go-client/bigquery/v2"
+	"errors"
+	"fmt"
+	"impact/data/bqsql"
+	"impact/data/model"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const aggregateKind = "NDTAggregate"
+
+// Format of the months the indexer is asked to index
+const MonthFormat = "2006-01"
+
+// Most entities a single datastore call may write
+const putBatchSize = 500
+
+// Locations with fewer tests in a month are left to live queries
+var MinIndexedTests int64 = 100
+
+var errMonthRange = errors.New("No NDT table exists for this month")
+
+/*
+ * Datastore entity holding the tests of a month at a city, region, country or,
+ * with every location part empty, the whole world. The sums are kept rather
+ * than averages so that months and levels add up exactly; Sums, SumSquares
+ * and Counts are parallel to Fields.
+ */
+type Aggregate struct {
+	Month      time.Time
+	Country    string
+	Region     string
+	City       string
+	Tests      int64
+	Fields     []string  `datastore:",noindex"`
+	Sums       []float64 `datastore:",noindex"`
+	SumSquares []float64 `datastore:",noindex"`
+	Counts     []int64   `datastore:",noindex"`
+}
+
+func newAggregate(month time.Time, country string, region string, city string, fields []string) *Aggregate {
+	return &Aggregate{
+		Month:      month,
+		Country:    country,
+		Region:     region,
+		City:       city,
+		Fields:     fields,
+		Sums:       make([]float64, len(fields)),
+		SumSquares: make([]float64, len(fields)),
+		Counts:     make([]int64, len(fields)),
+	}
+}
+
+// Adds the tests of other, which must have the same Fields
+func (a *Aggregate) add(other *Aggregate) {
+	a.Tests += other.Tests
+	for i := range a.Fields {
+		a.Sums[i] += other.Sums[i]
+		a.SumSquares[i] += other.SumSquares[i]
+		a.Counts[i] += other.Counts[i]
+	}
+}
+
+// Average and sample standard deviation of a field, false if it was not indexed
+func (a *Aggregate) stats(field string) (*model.FieldStats, bool) {
+	for i, name := range a.Fields {
+		if name != field {
+			continue
+		}
+		stats := &model.FieldStats{}
+		n := float64(a.Counts[i])
+		if n > 0 {
+			stats.Average = a.Sums[i] / n
+		}
+		if n > 1 {
+			stats.Stdev = math.Sqrt(math.Max(0, (a.SumSquares[i]-n*stats.Average*stats.Average)/(n-1)))
+		}
+		return stats, true
+	}
+	return nil, false
+}
+
+func aggregateKeyName(month time.Time, country string, region string, city string) string {
+	return strings.ToLower(fmt.Sprintf("%v|%v|%v|%v", month.Format(MonthFormat), country, region, city))
+}
+
+/*
+ * The location parts an aggregate of loc at level is stored under. named is
+ * false for levels loc does not name, which the query leaves out, and ok is
+ * false when a named level cannot be looked up because loc skips a wider
+ * level, e.g. a city without its region.
+ */
+func aggregateLocation(loc *model.Location, level int) (country string, region string, city string, named bool, ok bool) {
+	switch level {
+	case cityLevel:
+		if loc.City == "" {
+			return "", "", "", false, true
+		}
+		return loc.Country, regionName(loc), loc.City, true, loc.Country != "" && loc.Region != ""
+	case regionLevel:
+		if loc.Region == "" {
+			return "", "", "", false, true
+		}
+		return loc.Country, regionName(loc), "", true, loc.Country != ""
+	case countryLevel:
+		if loc.Country == "" {
+			return "", "", "", false, true
+		}
+		return loc.Country, "", "", true, true
+	}
+	return "", "", "", true, true
+}
+
+// Parses a month in MonthFormat, refusing months without an NDT table
+func ParseMonth(str string) (time.Time, error) {
+	month, err := time.Parse(MonthFormat, str)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Unparseable month %q", str)
+	}
+	if month.Before(FirstTableMonth) {
+		return time.Time{}, errMonthRange
+	}
+	return month, nil
+}
+
+/*
+ * Selects, per client city, the test count and the sum, sum of squares and
+ * count of each field. parseIndexRows reads them back in that order.
+ */
+func (ndt *NDT) getIndexQuery(month time.Time, fields []string) string {
+	columns := []bqsql.Expr{clientCountryField, clientRegionField, clientCityField, bqsql.Count()}
+	for _, field := range fields {
+		expr := snapField(field)
+		columns = append(columns,
+			bqsql.Func("SUM", expr),
+			bqsql.Func("SUM", bqsql.Func("POW", expr, bqsql.Int(2))),
+			bqsql.Func("COUNT", expr))
+	}
+	monthRange := &TimeRange{Start: month, End: month.AddDate(0, 1, 0)}
+	return bqsql.Select(columns...).
+		From(ndt.getQueryTable(month.Year(), int(month.Month()))).
+		Where(monthRange.condition()).
+		GroupBy(clientCountryField, clientRegionField, clientCityField).
+		String()
+}
+
+/*
+ * Rolls the rows of the index query up into aggregates for every city,
+ * region and country, and the world, keyed by aggregateKeyName. Locations
+ * with fewer than MinIndexedTests tests are dropped.
+ */
+func parseIndexRows(month time.Time, fields []string, rows []*bigquery.TableRow, aggregates map[string]*Aggregate) {
+	for _, row := range rows {
+		country, region, city := row.F[0].V, row.F[1].V, row.F[2].V
+		cityAggregate := newAggregate(month, country, region, city, fields)
+		cityAggregate.Tests = int64(cellFloat(row.F[3]))
+		pos := 4
+		for i := range fields {
+			cityAggregate.Sums[i] = cellFloat(row.F[pos])
+			cityAggregate.SumSquares[i] = cellFloat(row.F[pos+1])
+			cityAggregate.Counts[i] = int64(cellFloat(row.F[pos+2]))
+			pos += 3
+		}
+
+		loc := &model.Location{Country: country, Region: region, City: city}
+		for level := cityLevel; level < levelCount; level++ {
+			country, region, city, named, ok := aggregateLocation(loc, level)
+			if !named || !ok {
+				continue
+			}
+			name := aggregateKeyName(month, country, region, city)
+			if aggregates[name] == nil {
+				aggregates[name] = newAggregate(month, country, region, city, fields)
+			}
+			aggregates[name].add(cityAggregate)
+		}
+	}
+}
+
+func dropUncommon(aggregates map[string]*Aggregate) {
+	for name, aggregate := range aggregates {
+		isWorld := aggregate.Country == "" && aggregate.Region == "" && aggregate.City == ""
+		if !isWorld && aggregate.Tests < MinIndexedTests {
+			delete(aggregates, name)
+		}
+	}
+}
+
+/*
+ * Aggregates the tests of a month into the datastore, replacing any earlier
+ * run. Meant for the indexer backend, as it waits for BigQuery and reads
+ * every page of the result. Returns the number of aggregates written.
+ */
+func (ndt *NDT) IndexMonth(r *http.Request, month time.Time) (int, error) {
+	aggregates := make(map[string]*Aggregate)
+	err := ndt.readAllRows(r, ndt.getIndexQuery(month, DefaultFields), time.Time{}, func(rows []*bigquery.TableRow) {
+		parseIndexRows(month, DefaultFields, rows, aggregates)
+	})
+	if err != nil {
+		return 0, err
+	}
+	dropUncommon(aggregates)
+	return len(aggregates), putAggregates(r, aggregates)
+}
+
+/*
+ * Runs query and hands every page of its rows to handle, waiting for
+ * BigQuery until deadline, or for as long as the query takes when deadline
+ * is zero. A query still running at its deadline is cancelled and fails
+ * with errQueryDeadline.
+ */
+func (ndt *NDT) readAllRows(r *http.Request, query string, deadline time.Time, handle func(rows []*bigquery.TableRow)) error {
+	response, err := ndt.askBigQuery(r, query)
+	if err != nil {
+		return err
+	}
+	jobID := response.JobReference.JobId
+
+	complete := response.JobComplete
+	var startIndex uint64
+	for !complete || startIndex < response.TotalRows {
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			if err := ndt.cancelQuery(r, jobID); err != nil {
+				c := appengine.NewContext(r)
+				c.Errorf("readAllRows cancelQuery(%v) err = %v", jobID, err)
+			}
+			return errQueryDeadline
+		}
+		page, err := ndt.getQueryResults(r, jobID, startIndex, MaxPageSize)
+		if err != nil {
+			return err
+		}
+		complete = page.JobComplete
+		if !complete {
+			continue
+		}
+		response.TotalRows = page.TotalRows
+		if len(page.Rows) == 0 {
+			break
+		}
+		handle(page.Rows)
+		startIndex += uint64(len(page.Rows))
+	}
+	return nil
+}
+
+func putAggregates(r *http.Request, aggregates map[string]*Aggregate) error {
+	c := appengine.NewContext(r)
+	keys := []*datastore.Key{}
+	values := []*Aggregate{}
+	for name, aggregate := range aggregates {
+		keys = append(keys, datastore.NewKey(c, aggregateKind, name, 0, nil))
+		values = append(values, aggregate)
+		if len(keys) == putBatchSize {
+			if _, err := datastore.PutMulti(c, keys, values); err != nil {
+				return err
+			}
+			keys, values = keys[:0], values[:0]
+		}
+	}
+	if len(keys) > 0 {
+		_, err := datastore.PutMulti(c, keys, values)
+		return err
+	}
+	return nil
+}
+
+/*
+ * Whether a query can be answered from the index: only ranges of whole
+ * months the request asked for are indexed, and only without server
+ * restrictions. Quantiles cannot be added up across months and levels, so
+ * queries asking for distributions are left to BigQuery.
+ */
+func indexable(timeRange *TimeRange, distributions []string, serverLoc *model.Location) bool {
+	if serverLoc.Country != "" || serverLoc.Region != "" || serverLoc.City != "" ||
+		serverLoc.Site != "" || serverLoc.Metro != "" {
+		return false
+	}
+	return len(distributions) == 0 && timeRange.wholeMonths()
+}
+
+/*
+ * Answers a query from the aggregates of the months it covers. Reports
+ * false, leaving the query to BigQuery, when it is not indexable or some
+ * level it needs was not indexed, as for uncommon locations.
+ */
+func (ndt *NDT) precomputed(r *http.Request, fields []string, distributions []string, timeRange *TimeRange, clientLoc *model.Location, serverLoc *model.Location) (*model.NetworkData, bool) {
+	if !indexable(timeRange, distributions, serverLoc) {
+		return nil, false
+	}
+	return ndt.precomputedRange(r, fields, timeRange, clientLoc)
+}
+
+// The key names of the aggregates of each month and level, and the level of each
+func aggregateKeyNames(timeRange *TimeRange, clientLoc *model.Location) ([]string, []int, bool) {
+	names := []string{}
+	levels := []int{}
+	for _, month := range timeRange.Months() {
+		for level := cityLevel; level < levelCount; level++ {
+			country, region, city, named, ok := aggregateLocation(clientLoc, level)
+			if !ok {
+				return nil, nil, false
+			}
+			if named {
+				names = append(names, aggregateKeyName(month, country, region, city))
+				levels = append(levels, level)
+			}
+		}
+	}
+	return names, levels, true
+}
+
+// Reads every aggregate of timeRange in a single datastore call
+func (ndt *NDT) precomputedRange(r *http.Request, fields []string, timeRange *TimeRange, clientLoc *model.Location) (*model.NetworkData, bool) {
+	names, keyLevels, ok := aggregateKeyNames(timeRange, clientLoc)
+	if !ok {
+		return nil, false
+	}
+	c := appengine.NewContext(r)
+	keys := make([]*datastore.Key, len(names))
+	aggregates := make([]*Aggregate, len(names))
+	for i, name := range names {
+		keys[i] = datastore.NewKey(c, aggregateKind, name, 0, nil)
+		aggregates[i] = &Aggregate{}
+	}
+	// a missing aggregate fails the whole call
+	if err := datastore.GetMulti(c, keys, aggregates); err != nil {
+		return nil, false
+	}
+
+	levels := make([]*Aggregate, levelCount)
+	for i, aggregate := range aggregates {
+		if level := keyLevels[i]; levels[level] == nil {
+			levels[level] = aggregate
+		} else {
+			levels[level].add(aggregate)
+		}
+	}
+
+	result := model.NewNetworkData()
+	result.Start = timeRange.Start
+	result.End = timeRange.End
+	return result, summarizeAggregates(fields, levels, result)
+}
+
+/*
+ * Fills result from the aggregates of each level, nil for levels the client
+ * location does not name, the way parseRows fills it from a live query,
+ * for queries without distributions. Reports false if a field was not
+ * indexed.
+ */
+func summarizeAggregates(fields []string, levels []*Aggregate, result *model.NetworkData) bool {
+	var local *Aggregate
+	for level := worldLevel; level >= cityLevel; level-- {
+		if levels[level] != nil {
+			local = levels[level]
+		}
+	}
+	if local == nil {
+		return false
+	}
+
+	for _, field := range fields {
+		stats, ok := local.stats(field)
+		if !ok {
+			return false
+		}
+		result.Fields[field] = stats
+	}
+
+	levelMetrics := make([]map[string]float64, levelCount)
+	for level, aggregate := range levels {
+		averages := make(map[string]float64)
+		if aggregate != nil && aggregate.Tests > 0 {
+			for _, field := range metricFields {
+				if stats, ok := aggregate.stats(field); ok {
+					averages[field] = stats.Average
+				}
+			}
+			levelMetrics[level] = computeMetrics(averages)
+		} else {
+			levelMetrics[level] = averages
+		}
+	}
+
+	result.Complete = true
+	result.SampleSize = local.Tests
+	result.Metrics = compareMetrics(levelMetrics)
+	result.Derived = computeDerived(result.Fields)
+	return true
+}
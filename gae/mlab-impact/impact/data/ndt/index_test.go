@@ -0,0 +1,162 @@
+package ndt
+
+// Unit tests for the precomputed monthly aggregates.
+
+import (
+	"code.google.com/p/google-api-go-client/bigquery/v2"
+	"impact/data/model"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testMonth = time.Date(2012, 6, 1, 0, 0, 0, 0, time.UTC)
+
+// Builds an index query row for a city with a single field.
+func testIndexRow(country string, region string, city string, tests float64, sum float64, sumSquares float64) *bigquery.TableRow {
+	row := testRow(tests, sum, sumSquares, tests)[0]
+	location := []*bigquery.TableRowF{{V: country}, {V: region}, {V: city}}
+	row.F = append(location, row.F...)
+	return row
+}
+
+// Makes sure that cities are rolled up into their region, country and the world.
+func TestParseIndexRows(t *testing.T) {
+	minTests := MinIndexedTests
+	MinIndexedTests = 100
+	defer func() {
+		MinIndexedTests = minTests
+	}()
+
+	rows := []*bigquery.TableRow{
+		testIndexRow("United States", "North Carolina", "Durham", 150, 1500, 16500),
+		testIndexRow("United States", "North Carolina", "Cary", 50, 1000, 20000),
+		testIndexRow("Canada", "", "", 20, 200, 2000),
+	}
+	aggregates := make(map[string]*Aggregate)
+	parseIndexRows(testMonth, []string{"MinRTT"}, rows, aggregates)
+	dropUncommon(aggregates)
+
+	expected := map[string]int64{
+		"2012-06|united states|north carolina|durham": 150,
+		"2012-06|united states|north carolina|":       200,
+		"2012-06|united states||":                     200,
+		"2012-06|||":                                  220,
+	}
+	if len(aggregates) != len(expected) {
+		t.Errorf("TestParseIndexRows kept %v aggregates", len(aggregates))
+	}
+	for name, tests := range expected {
+		if aggregates[name] == nil || aggregates[name].Tests != tests {
+			t.Errorf("TestParseIndexRows %v = %+v", name, aggregates[name])
+		}
+	}
+	if sum := aggregates["2012-06|united states|north carolina|"].Sums[0]; sum != 2500 {
+		t.Errorf("TestParseIndexRows region sum = %v", sum)
+	}
+}
+
+// Makes sure that the index query sums every field per client city.
+func TestGetIndexQuery(t *testing.T) {
+	query := NDT_Source().getIndexQuery(testMonth, []string{"MinRTT"})
+	for _, part := range []string{
+		"SUM(POW(web100_log_entry.snap.MinRTT, 2))",
+		"FROM [m_lab.2012_06]",
+		"GROUP BY connection_spec.client_geolocation.country_name",
+	} {
+		if !strings.Contains(query, part) {
+			t.Errorf("TestGetIndexQuery missing %v in %v", part, query)
+		}
+	}
+}
+
+// Makes sure that only levels with their wider levels can be looked up.
+func TestAggregateLocation(t *testing.T) {
+	loc := &model.Location{Country: "United States", City: "Durham"}
+	if _, _, _, named, ok := aggregateLocation(loc, cityLevel); !named || ok {
+		t.Errorf("TestAggregateLocation allowed a city without its region")
+	}
+	if _, _, _, named, _ := aggregateLocation(loc, regionLevel); named {
+		t.Fail()
+	}
+	if country, _, _, named, ok := aggregateLocation(loc, countryLevel); !named || !ok || country != "United States" {
+		t.Errorf("TestAggregateLocation country = %v", country)
+	}
+}
+
+// Makes sure that only requested whole months without distributions are answered from aggregates.
+func TestIndexable(t *testing.T) {
+	months := &TimeRange{Start: testMonth, End: testMonth.AddDate(0, 2, 0)}
+	if !indexable(months, []string{}, &model.Location{}) {
+		t.Errorf("TestIndexable refused whole months")
+	}
+	if indexable(months, DefaultDistributionFields, &model.Location{}) {
+		t.Errorf("TestIndexable would answer distributions from aggregates")
+	}
+	if indexable(months, []string{}, &model.Location{Metro: "lga"}) {
+		t.Errorf("TestIndexable accepted a server restriction")
+	}
+	if indexable(&TimeRange{Start: testMonth, End: testMonth.AddDate(0, 0, 20)}, []string{}, &model.Location{}) {
+		t.Errorf("TestIndexable accepted part of a month")
+	}
+
+	r, _ := http.NewRequest("GET", "/query?distributions=none", nil)
+	timeRange, _ := ParseTimeRange(r, time.Date(2012, 7, 12, 15, 0, 0, 0, time.UTC))
+	if indexable(timeRange, []string{}, &model.Location{}) {
+		t.Errorf("TestIndexable moved a default trailing window onto the index")
+	}
+	r, _ = http.NewRequest("GET", "/query?start=2012-06-01&end=2012-06-30", nil)
+	timeRange, _ = ParseTimeRange(r, time.Date(2012, 7, 12, 15, 0, 0, 0, time.UTC))
+	if !indexable(timeRange, []string{}, &model.Location{}) {
+		t.Errorf("TestIndexable refused a requested month, %v to %v", timeRange.Start, timeRange.End)
+	}
+}
+
+// Makes sure that every month and named level of a query is looked up, and nothing else.
+func TestAggregateKeyNames(t *testing.T) {
+	months := &TimeRange{Start: testMonth, End: testMonth.AddDate(0, 2, 0)}
+	names, levels, ok := aggregateKeyNames(months, &model.Location{Country: "Canada"})
+	if !ok || len(names) != 4 || len(levels) != 4 {
+		t.Fatalf("TestAggregateKeyNames got %v, %v, %v", names, levels, ok)
+	}
+	if names[0] != "2012-06|canada||" || levels[0] != countryLevel || names[3] != "2012-07|||" || levels[3] != worldLevel {
+		t.Errorf("TestAggregateKeyNames got %v, %v", names, levels)
+	}
+	if _, _, ok := aggregateKeyNames(months, &model.Location{Country: "Canada", City: "Toronto"}); ok {
+		t.Errorf("TestAggregateKeyNames looked up a city without its region")
+	}
+}
+
+// Makes sure that aggregates are summarized like a live query.
+func TestSummarizeAggregates(t *testing.T) {
+	fields := []string{"MinRTT", "SumRTT", "CountRTT"}
+	levels := make([]*Aggregate, levelCount)
+	for _, level := range []int{regionLevel, worldLevel} {
+		aggregate := newAggregate(testMonth, "", "", "", fields)
+		aggregate.Tests = 4
+		aggregate.Sums = []float64{40, float64(int(40) << uint(level)), 4}
+		aggregate.SumSquares = []float64{412, 0, 0}
+		aggregate.Counts = []int64{4, 4, 4}
+		levels[level] = aggregate
+	}
+
+	result := model.NewNetworkData()
+	if !summarizeAggregates(fields, levels, result) {
+		t.Fatalf("TestSummarizeAggregates refused the aggregates")
+	}
+	if stats := result.Fields["MinRTT"]; stats.Average != 10 || stats.Stdev != 2 {
+		t.Errorf("TestSummarizeAggregates MinRTT = %+v", stats)
+	}
+	rtt := result.Metrics[RTTMetric]
+	if rtt == nil || rtt.Local != 20 || rtt.City != nil || *rtt.World != 80 {
+		t.Errorf("TestSummarizeAggregates RTT = %+v", rtt)
+	}
+	if !result.Complete || result.SampleSize != 4 {
+		t.Errorf("TestSummarizeAggregates result = %+v", result)
+	}
+
+	if summarizeAggregates([]string{"MaxRTT"}, levels, model.NewNetworkData()) {
+		t.Errorf("TestSummarizeAggregates answered for a field that was not indexed")
+	}
+}
@@ -0,0 +1,316 @@
+package ndt
+
+import (
+	"appengine"
+	"appengine/datastore"
+	"appengine/user"
+	"code.google.com/p/google-api-go-client/bigquery/v2"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"impact/data/model"
+	"net/http"
+	"time"
+)
+
+const jobKind = "NDTJob"
+
+// Rows a page of job results holds unless the caller asks for fewer
+const (
+	DefaultPageSize = 100
+	MaxPageSize     = 1000
+)
+
+// How long a request for job results waits on BigQuery before answering
+var JobResultsTimeout = 5 * time.Second
+
+var (
+	errJobUnknown   = errors.New("No NDT job with this ID was submitted")
+	errJobCancelled = errors.New("The NDT job has been cancelled")
+	errJobForbidden = errors.New("Only the user who submitted a job or an administrator can cancel it")
+)
+
+// Root of the BigQuery v2 REST API, for the calls its client lacks
+const bigqueryBasePath = "https://www.googleapis.com/bigquery/v2/"
+
+/*
+ * Datastore entity behind a model.JobHandle, keyed by the BigQuery job ID.
+ * The locations are stored as JSON as they are only ever read back whole.
+ */
+type jobRecord struct {
+	Kind          string
+	Period        string
+	Fields        []string
+	Distributions []string
+	Client        []byte
+	Server        []byte
+	Start         time.Time
+	End           time.Time
+	Submitted     time.Time
+	Cancelled     bool
+	Owner         string
+}
+
+func newJobHandle(id string, kind string, timeRange *TimeRange, clientLoc *model.Location, serverLoc *model.Location) *model.JobHandle {
+	return &model.JobHandle{
+		ID:        id,
+		Kind:      kind,
+		Client:    clientLoc,
+		Server:    serverLoc,
+		Start:     timeRange.Start,
+		End:       timeRange.End,
+		Submitted: time.Now(),
+	}
+}
+
+func newJobRecord(job *model.JobHandle) (*jobRecord, error) {
+	client, err := json.Marshal(job.Client)
+	if err != nil {
+		return nil, err
+	}
+	server, err := json.Marshal(job.Server)
+	if err != nil {
+		return nil, err
+	}
+	return &jobRecord{
+		Kind:          job.Kind,
+		Period:        job.Period,
+		Fields:        job.Fields,
+		Distributions: job.Distributions,
+		Client:        client,
+		Server:        server,
+		Start:         job.Start,
+		End:           job.End,
+		Submitted:     job.Submitted,
+		Cancelled:     job.Cancelled,
+		Owner:         job.Owner,
+	}, nil
+}
+
+func (record *jobRecord) handle(id string) (*model.JobHandle, error) {
+	job := &model.JobHandle{
+		ID:            id,
+		Kind:          record.Kind,
+		Period:        record.Period,
+		Fields:        record.Fields,
+		Distributions: record.Distributions,
+		Start:         record.Start,
+		End:           record.End,
+		Submitted:     record.Submitted,
+		Cancelled:     record.Cancelled,
+		Owner:         record.Owner,
+	}
+	// jobs recorded before distributions were chosen computed one for every field
+	if job.Distributions == nil {
+		job.Distributions = job.Fields
+	}
+	if err := json.Unmarshal(record.Client, &job.Client); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(record.Server, &job.Server); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// Records a job just submitted for the signed in user, if any, so its rows can be read back later
+func submitJob(r *http.Request, job *model.JobHandle) error {
+	if u := user.Current(appengine.NewContext(r)); u != nil {
+		job.Owner = u.ID
+	}
+	return saveJob(r, job)
+}
+
+func saveJob(r *http.Request, job *model.JobHandle) error {
+	record, err := newJobRecord(job)
+	if err != nil {
+		return err
+	}
+	c := appengine.NewContext(r)
+	key := datastore.NewKey(c, jobKind, job.ID, 0, nil)
+	_, err = datastore.Put(c, key, record)
+	return err
+}
+
+// Looks up a submitted job, failing with ErrCodeNotFound for unknown IDs
+func LoadJob(r *http.Request, jobID string) (*model.JobHandle, error) {
+	c := appengine.NewContext(r)
+	key := datastore.NewKey(c, jobKind, jobID, 0, nil)
+	record := &jobRecord{}
+	err := datastore.Get(c, key, record)
+	if err == datastore.ErrNoSuchEntity {
+		return nil, model.NewSourceError(model.ErrCodeNotFound, errJobUnknown)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return record.handle(jobID)
+}
+
+/*
+ * Reports the state of a job as BigQuery sees it, along with how much data it
+ * has processed so far. Cancelled jobs report model.JobCancelled.
+ */
+func (ndt *NDT) JobStatus(r *http.Request, jobID string) (*model.JobStatus, error) {
+	job, err := LoadJob(r, jobID)
+	if err != nil {
+		return nil, err
+	}
+	status := newJobStatus(job)
+	if job.Cancelled {
+		return status, nil
+	}
+
+	bigqueryService, err := bigquery.New(getJWTClient(r))
+	if err != nil {
+		return nil, model.NewSourceError(model.ErrCodeUnavailable, err)
+	}
+	bqJob, err := bigqueryService.Jobs.Get(ndt.ProjectID, jobID).Do()
+	if err != nil {
+		return nil, model.NewSourceError(model.ErrCodeUnavailable, err)
+	}
+	fillJobStatus(bqJob, status)
+	return status, nil
+}
+
+// The status of a job before BigQuery is asked about it
+func newJobStatus(job *model.JobHandle) *model.JobStatus {
+	status := &model.JobStatus{Job: job, State: model.JobUnknown}
+	if job.Cancelled {
+		status.State = model.JobCancelled
+	}
+	return status
+}
+
+func fillJobStatus(bqJob *bigquery.Job, status *model.JobStatus) {
+	status.State = model.JobPending
+	if bqJob.Status != nil {
+		status.State = bqJob.Status.State
+		if bqJob.Status.ErrorResult != nil {
+			status.Error = bqJob.Status.ErrorResult.Message
+		}
+	}
+	if stats := bqJob.Statistics; stats != nil {
+		status.BytesProcessed = stats.TotalBytesProcessed
+		// BigQuery reports times in milliseconds since the epoch
+		if stats.StartTime != 0 {
+			started := time.Unix(0, stats.StartTime*int64(time.Millisecond)).UTC()
+			status.Started = &started
+		}
+		if stats.EndTime != 0 {
+			finished := time.Unix(0, stats.EndTime*int64(time.Millisecond)).UTC()
+			status.Finished = &finished
+		}
+	}
+}
+
+/*
+ * Reads up to maxResults rows of a job starting at startIndex, parsing them
+ * with the fields or period the job was submitted with. A maxResults of 0
+ * asks for DefaultPageSize rows.
+ */
+func (ndt *NDT) JobResults(r *http.Request, jobID string, startIndex uint64, maxResults int64) (*model.JobPage, error) {
+	job, err := LoadJob(r, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if job.Cancelled {
+		return nil, model.NewSourceError(model.ErrCodeCancelled, errJobCancelled)
+	}
+	if maxResults <= 0 {
+		maxResults = DefaultPageSize
+	}
+	if maxResults > MaxPageSize {
+		maxResults = MaxPageSize
+	}
+
+	response, err := ndt.getQueryResults(r, jobID, startIndex, maxResults)
+	if err != nil {
+		return nil, model.NewSourceError(model.ErrCodeUnavailable, err)
+	}
+	return ndt.parseJobPage(job, response, startIndex), nil
+}
+
+func (ndt *NDT) parseJobPage(job *model.JobHandle, response *bigquery.GetQueryResultsResponse, startIndex uint64) *model.JobPage {
+	page := &model.JobPage{
+		Job:        job,
+		Complete:   response.JobComplete,
+		StartIndex: startIndex,
+		TotalRows:  response.TotalRows,
+	}
+	if next := startIndex + uint64(len(response.Rows)); response.JobComplete && next < response.TotalRows {
+		page.NextIndex = next
+	}
+
+	if job.Kind == model.JobTrend {
+		page.Trend = model.NewTrend(job.Period)
+		page.Trend.Complete = response.JobComplete
+		if response.JobComplete {
+			ndt.parseTrendRows(response.Rows, page.Trend)
+		} else {
+			page.Trend.JobID = job.ID
+			page.Trend.Job = job
+		}
+		return page
+	}
+
+	page.Network = model.NewNetworkData()
+	page.Network.Complete = response.JobComplete
+	page.Network.Start = job.Start
+	page.Network.End = job.End
+	if response.JobComplete {
+		ndt.parseRows(job.Fields, job.Distributions, response.Rows, page.Network)
+	} else {
+		page.Network.JobID = job.ID
+		page.Network.Job = job
+	}
+	return page
+}
+
+// Only the user who submitted a job and administrators may cancel it; anonymous jobs are the administrators' to cancel
+func mayCancel(u *user.User, admin bool, job *model.JobHandle) bool {
+	return admin || (u != nil && job.Owner != "" && u.ID == job.Owner)
+}
+
+/*
+ * Asks BigQuery to stop a job. The v2 client has no call for it, so the
+ * request is sent by hand. BigQuery answers successfully for jobs that have
+ * already finished.
+ */
+func (ndt *NDT) cancelQuery(r *http.Request, jobID string) error {
+	cancelURL := fmt.Sprintf("%vprojects/%v/jobs/%v/cancel", bigqueryBasePath, ndt.ProjectID, jobID)
+	response, err := getJWTClient(r).Post(cancelURL, "application/json", nil)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("BigQuery refused to cancel job %v: %v", jobID, response.Status)
+	}
+	return nil
+}
+
+/*
+ * Stops a job on BigQuery and stops handing out its rows. Fails with
+ * ErrCodeForbidden unless mayCancel allows the current user to.
+ */
+func (ndt *NDT) CancelJob(r *http.Request, jobID string) (*model.JobHandle, error) {
+	job, err := LoadJob(r, jobID)
+	if err != nil {
+		return nil, err
+	}
+	c := appengine.NewContext(r)
+	if !mayCancel(user.Current(c), user.IsAdmin(c), job) {
+		return nil, model.NewSourceError(model.ErrCodeForbidden, errJobForbidden)
+	}
+	if !job.Cancelled {
+		if err := ndt.cancelQuery(r, jobID); err != nil {
+			return nil, model.NewSourceError(model.ErrCodeUnavailable, err)
+		}
+	}
+	job.Cancelled = true
+	if err := saveJob(r, job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
@@ -0,0 +1,157 @@
+package ndt
+
+// Unit tests for reading back BigQuery jobs.
+
+import (
+	"appengine/user"
+	"code.google.com/p/google-api-go-client/bigquery/v2"
+	"impact/data/model"
+	"testing"
+	"time"
+)
+
+// The time range of the jobs under test
+var testJobRange = &TimeRange{
+	Start: time.Date(2012, 6, 1, 0, 0, 0, 0, time.UTC),
+	End:   time.Date(2012, 7, 1, 0, 0, 0, 0, time.UTC),
+}
+
+// Makes sure that a job handle survives being stored in the datastore.
+func TestJobRecord(t *testing.T) {
+	job := newJobHandle("job_1", model.JobData, testJobRange, &model.Location{City: "Durham"}, &model.Location{Metro: "lga"})
+	job.Fields = []string{"MinRTT", "MaxRTT"}
+	job.Owner = "42"
+
+	record, err := newJobRecord(job)
+	if err != nil {
+		t.Fatalf("TestJobRecord:newJobRecord err = %v", err)
+	}
+	loaded, err := record.handle("job_1")
+	if err != nil {
+		t.Fatalf("TestJobRecord:handle err = %v", err)
+	}
+	if loaded.ID != "job_1" || loaded.Kind != model.JobData || len(loaded.Fields) != 2 ||
+		loaded.Client.City != "Durham" || loaded.Server.Metro != "lga" || !loaded.Start.Equal(job.Start) ||
+		loaded.Owner != "42" {
+		t.Errorf("TestJobRecord loaded %+v", loaded)
+	}
+	// jobs recorded before distributions were chosen had one for every field
+	if len(loaded.Distributions) != 2 {
+		t.Errorf("TestJobRecord loaded distributions %v", loaded.Distributions)
+	}
+}
+
+// Makes sure that rows are parsed with the fields and distributions the job was submitted with.
+func TestParseJobPageFields(t *testing.T) {
+	ndt := NDT_Source()
+	job := newJobHandle("job_1", model.JobData, testJobRange, &model.Location{}, &model.Location{})
+	job.Fields = []string{"MinRTT", "MaxRTT"}
+	job.Distributions = []string{"MaxRTT"}
+
+	vals := []float64{3, 12, 1}
+	vals = append(vals, 90, 1, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 9.9)
+	for level := cityLevel; level < levelCount; level++ {
+		vals = append(vals, 0, 0, 0, 0, 0, 0, 0)
+	}
+	response := &bigquery.GetQueryResultsResponse{JobComplete: true, TotalRows: 1, Rows: testRow(vals...)}
+
+	page := ndt.parseJobPage(job, response, 0)
+	if page.Network == nil || !page.Network.Complete {
+		t.Fatalf("TestParseJobPageFields page = %+v", page)
+	}
+	if stats := page.Network.Fields["MaxRTT"]; stats == nil || stats.Average != 90 || stats.Distribution == nil {
+		t.Errorf("TestParseJobPageFields MaxRTT = %+v", stats)
+	}
+	if stats := page.Network.Fields["MinRTT"]; stats == nil || stats.Average != 12 || stats.Distribution != nil {
+		t.Errorf("TestParseJobPageFields MinRTT = %+v", stats)
+	}
+	if len(page.Network.Fields) != 2 || page.NextIndex != 0 {
+		t.Errorf("TestParseJobPageFields page = %+v", page)
+	}
+}
+
+// Makes sure that trend jobs are paged and unfinished jobs keep their handle.
+func TestParseJobPageTrend(t *testing.T) {
+	ndt := NDT_Source()
+	job := newJobHandle("job_2", model.JobTrend, testJobRange, &model.Location{}, &model.Location{})
+	job.Period = WeekPeriod
+
+	response := &bigquery.GetQueryResultsResponse{
+		JobComplete: true,
+		TotalRows:   5,
+		Rows:        testRow(1335830400000000, 10, 12500000, 10000000, 900, 30, 2, 400),
+	}
+	page := ndt.parseJobPage(job, response, 2)
+	if page.Trend == nil || len(page.Trend.Points) != 1 || page.Trend.Period != WeekPeriod {
+		t.Fatalf("TestParseJobPageTrend trend = %+v", page.Trend)
+	}
+	if page.NextIndex != 3 {
+		t.Errorf("TestParseJobPageTrend next index = %v", page.NextIndex)
+	}
+
+	page = ndt.parseJobPage(job, &bigquery.GetQueryResultsResponse{}, 0)
+	if page.Complete || page.Trend.Job != job || page.Trend.JobID != "job_2" {
+		t.Errorf("TestParseJobPageTrend pending trend = %+v", page.Trend)
+	}
+}
+
+// Makes sure that BigQuery's job state and statistics are reported.
+func TestFillJobStatus(t *testing.T) {
+	status := &model.JobStatus{}
+	fillJobStatus(&bigquery.Job{
+		Status:     &bigquery.JobStatus{State: model.JobDone, ErrorResult: &bigquery.ErrorProto{Message: "bad query"}},
+		Statistics: &bigquery.JobStatistics{StartTime: 1341316800000, TotalBytesProcessed: 2048},
+	}, status)
+
+	if status.State != model.JobDone || status.Error != "bad query" || status.BytesProcessed != 2048 {
+		t.Errorf("TestFillJobStatus status = %+v", status)
+	}
+	if status.Started == nil || !status.Started.Equal(time.Date(2012, 7, 3, 12, 0, 0, 0, time.UTC)) || status.Finished != nil {
+		t.Errorf("TestFillJobStatus started = %v, finished = %v", status.Started, status.Finished)
+	}
+
+	status = &model.JobStatus{}
+	fillJobStatus(&bigquery.Job{}, status)
+	if status.State != model.JobPending {
+		t.Errorf("TestFillJobStatus state = %v", status.State)
+	}
+}
+
+// Makes sure that a job only reports itself cancelled once it has been.
+func TestNewJobStatus(t *testing.T) {
+	job := newJobHandle("job_1", model.JobData, testJobRange, &model.Location{}, &model.Location{})
+	if status := newJobStatus(job); status.State != model.JobUnknown {
+		t.Errorf("TestNewJobStatus new job state = %v", status.State)
+	}
+	job.Cancelled = true
+	if status := newJobStatus(job); status.State != model.JobCancelled {
+		t.Errorf("TestNewJobStatus cancelled job state = %v", status.State)
+	}
+}
+
+// Makes sure that only administrators and the submitting user may cancel a job.
+func TestMayCancel(t *testing.T) {
+	owned := &model.JobHandle{ID: "job_1", Owner: "42"}
+	anonymous := &model.JobHandle{ID: "job_2"}
+	owner := &user.User{ID: "42"}
+	other := &user.User{ID: "7"}
+	tests := []struct {
+		u        *user.User
+		admin    bool
+		job      *model.JobHandle
+		expected bool
+	}{
+		{owner, false, owned, true},
+		{other, false, owned, false},
+		{nil, false, owned, false},
+		{other, true, owned, true},
+		{&user.User{}, false, anonymous, false},
+		{nil, false, anonymous, false},
+		{other, true, anonymous, true},
+	}
+	for i, test := range tests {
+		if got := mayCancel(test.u, test.admin, test.job); got != test.expected {
+			t.Errorf("TestMayCancel case %v got %v", i, got)
+		}
+	}
+}
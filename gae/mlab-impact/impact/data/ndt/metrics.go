@@ -0,0 +1,107 @@
+package ndt
+
+import (
+	"impact/data/model"
+)
+
+// Headline metrics computed from the web100 averages
+const (
+	ThroughputMetric = "Throughput"
+	RTTMetric        = "RTT"
+	LossMetric       = "Packet Loss"
+)
+
+// Levels the headline metrics are compared at, from most to least specific
+const (
+	cityLevel = iota
+	regionLevel
+	countryLevel
+	worldLevel
+	levelCount
+)
+
+// web100 fields the headline metrics are computed from
+var metricFields = []string{
+	"DataOctetsOut",
+	"Duration",
+	"SumRTT",
+	"CountRTT",
+	"CongSignals",
+	"DataSegsOut",
+}
+
+func ratio(numerator float64, denominator float64) (float64, bool) {
+	if denominator == 0 {
+		return 0, false
+	}
+	return numerator / denominator, true
+}
+
+/*
+ * Computes the headline metrics from the averages of metricFields.
+ *
+ * Throughput is in Mbit/s, from the octets sent over the test Duration in
+ * microseconds. RTT is in milliseconds, from the summed RTT samples over
+ * their count. Packet Loss is the ratio of congestion signals to data
+ * segments sent. Metrics whose inputs are missing are left out.
+ */
+func computeMetrics(averages map[string]float64) map[string]float64 {
+	metrics := make(map[string]float64)
+	if value, ok := throughput(averages); ok {
+		metrics[ThroughputMetric] = value
+	}
+	if value, ok := rtt(averages); ok {
+		metrics[RTTMetric] = value
+	}
+	if value, ok := loss(averages); ok {
+		metrics[LossMetric] = value
+	}
+	return metrics
+}
+
+func throughput(averages map[string]float64) (float64, bool) {
+	return ratio(averages["DataOctetsOut"]*8, averages["Duration"])
+}
+
+func rtt(averages map[string]float64) (float64, bool) {
+	return ratio(averages["SumRTT"], averages["CountRTT"])
+}
+
+func loss(averages map[string]float64) (float64, bool) {
+	return ratio(averages["CongSignals"], averages["DataSegsOut"])
+}
+
+/*
+ * Compares the metrics of each level, indexed by the level constants. Levels
+ * without the metric are left out and Local takes the most specific level
+ * that has it.
+ */
+func compareMetrics(levelMetrics []map[string]float64) map[string]*model.ComparativeValues {
+	comparison := make(map[string]*model.ComparativeValues)
+	for _, name := range []string{ThroughputMetric, RTTMetric, LossMetric} {
+		values := &model.ComparativeValues{}
+		found := false
+		for level := levelCount - 1; level >= 0; level-- {
+			value, ok := levelMetrics[level][name]
+			if !ok {
+				continue
+			}
+			found = true
+			values.Local = value
+			switch level {
+			case cityLevel:
+				values.City = &value
+			case regionLevel:
+				values.Region = &value
+			case countryLevel:
+				values.Country = &value
+			case worldLevel:
+				values.World = &value
+			}
+		}
+		if found {
+			comparison[name] = values
+		}
+	}
+	return comparison
+}
@@ -0,0 +1,113 @@
+package ndt
+
+// Unit tests for the headline network metrics.
+
+import (
+	"code.google.com/p/google-api-go-client/bigquery/v2"
+	"fmt"
+	"impact/data/model"
+	"testing"
+)
+
+// Makes sure that the metrics come out in the documented units.
+func TestComputeMetrics(t *testing.T) {
+	metrics := computeMetrics(map[string]float64{
+		"DataOctetsOut": 12500000,
+		"Duration":      10000000,
+		"SumRTT":        900,
+		"CountRTT":      30,
+		"CongSignals":   2,
+		"DataSegsOut":   400,
+	})
+	if metrics[ThroughputMetric] != 10 {
+		t.Errorf("TestComputeMetrics throughput = %v", metrics[ThroughputMetric])
+	}
+	if metrics[RTTMetric] != 30 {
+		t.Errorf("TestComputeMetrics RTT = %v", metrics[RTTMetric])
+	}
+	if metrics[LossMetric] != 0.005 {
+		t.Errorf("TestComputeMetrics loss = %v", metrics[LossMetric])
+	}
+
+	metrics = computeMetrics(map[string]float64{})
+	if len(metrics) != 0 {
+		t.Errorf("TestComputeMetrics computed %v without inputs", metrics)
+	}
+}
+
+// Builds a row of cells holding vals.
+func testRow(vals ...float64) []*bigquery.TableRow {
+	cells := make([]*bigquery.TableRowF, len(vals))
+	for i, val := range vals {
+		cells[i] = &bigquery.TableRowF{V: fmt.Sprintf("%v", val)}
+	}
+	return []*bigquery.TableRow{&bigquery.TableRow{F: cells}}
+}
+
+// Makes sure that each level only filters on the parts of the location it names.
+func TestGetLevelCondition(t *testing.T) {
+	ndt := NDT_Source()
+	loc := &model.Location{Country: "United States", Region: "North Carolina"}
+
+	expected := []string{
+		"false",
+		`(connection_spec.client_geolocation.country_name="United States") AND (connection_spec.client_geolocation.region="North Carolina")`,
+		`connection_spec.client_geolocation.country_name="United States"`,
+		"",
+	}
+	for level, condition := range expected {
+		if got := string(ndt.getLevelCondition(loc, level)); got != condition {
+			t.Errorf("TestGetLevelCondition level %v = %v", level, got)
+		}
+	}
+	if string(ndt.getLocalCondition(loc)) != expected[regionLevel] {
+		t.Errorf("TestGetLevelCondition local = %v", ndt.getLocalCondition(loc))
+	}
+	for _, region := range []string{"NC", "37", "north carolina"} {
+		abbreviated := &model.Location{Country: "United States", Region: region}
+		if got := string(ndt.getLevelCondition(abbreviated, regionLevel)); got != expected[regionLevel] {
+			t.Errorf("TestGetLevelCondition region %v = %v", region, got)
+		}
+	}
+}
+
+// Makes sure that parseRows reads back the columns of getQueryFields.
+func TestParseRows(t *testing.T) {
+	ndt := NDT_Source()
+	vals := []float64{
+		// local sample size, MinRTT average and standard deviation
+		5, 12, 3,
+		// MinRTT deciles and 99th percentile
+		0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 9.9,
+		// no tests at the city level
+		0, 0, 0, 0, 0, 0, 0,
+	}
+	for level := regionLevel; level < levelCount; level++ {
+		// metricFields at each level, RTT doubling with each level
+		vals = append(vals, 5, 1, 1, float64(int(10)<<uint(level)), 1, 1, 100)
+	}
+
+	result := model.NewNetworkData()
+	ndt.parseRows([]string{"MinRTT"}, []string{"MinRTT"}, testRow(vals...), result)
+
+	if result.SampleSize != 5 {
+		t.Errorf("TestParseRows sample size = %v", result.SampleSize)
+	}
+	if stats := result.Fields["MinRTT"]; stats == nil || stats.Average != 12 || stats.Stdev != 3 {
+		t.Errorf("TestParseRows MinRTT = %v", stats)
+	}
+	if d := result.Fields["MinRTT"].Distribution; d == nil || d.P10 != 1 || d.Median != 5 ||
+		d.P90 != 9 || d.P99 != 9.9 || len(d.Buckets) != 10 {
+		t.Errorf("TestParseRows MinRTT distribution = %+v", d)
+	} else if b := d.Buckets[3]; b.Low != 3 || b.High != 4 || b.Fraction != 0.1 {
+		t.Errorf("TestParseRows MinRTT bucket = %+v", b)
+	}
+	rtt := result.Metrics[RTTMetric]
+	if rtt == nil || rtt.City != nil || rtt.Local != 20 || *rtt.Region != 20 ||
+		*rtt.Country != 40 || *rtt.World != 80 {
+		t.Errorf("TestParseRows RTT = %+v", rtt)
+	}
+	if result.Metrics[LossMetric] == nil || result.Metrics[ThroughputMetric] == nil {
+		t.Errorf("TestParseRows metrics = %v", result.Metrics)
+	}
+}
@@ -3,7 +3,15 @@ package ndt
 import (
 	"code.google.com/p/google-api-go-client/bigquery/v2"
 	"fmt"
+	"impact/data/bqsql"
+	"impact/data/cache"
+	"impact/data/census"
+	"impact/data/model"
+	"impact/data/registry"
 	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -12,6 +20,10 @@ type NDT struct {
 	DatasetID string
 }
 
+func init() {
+	registry.Register(NDT_Source())
+}
+
 func NDT_Source() *NDT {
 
 	n := &NDT{
@@ -60,87 +72,245 @@ var (
 		"RcvRTT",
 		"CurRwinRcvd",
 		"MaxRwinRcvd",
+		"PktsRetrans",
+		"SndLimTimeRwin",
+		"SndLimTimeCwnd",
+		"SndLimTimeSnd",
 	}
 )
 
-func (ndt *NDT) getQueryWhere(clientLoc map[string]interface{}, serverLoc map[string]interface{}) string {
-	whereStr := ""
+// Fields of the NDT tables used to pick tests
+var (
+	clientCountryField = bqsql.Field("connection_spec.client_geolocation.country_name")
+	clientRegionField  = bqsql.Field("connection_spec.client_geolocation.region")
+	clientCityField    = bqsql.Field("connection_spec.client_geolocation.city")
+	serverCountryField = bqsql.Field("connection_spec.server_geolocation.country_name")
+	serverRegionField  = bqsql.Field("connection_spec.server_geolocation.region")
+	serverCityField    = bqsql.Field("connection_spec.server_geolocation.city")
+	serverHostField    = bqsql.Field("connection_spec.server_hostname")
+)
 
-	if clientLoc["Country"] != nil {
-		whereStr = fmt.Sprintf("connection_spec.client_geolocation.country_name=\"%v\"", clientLoc["Country"])
-		if clientLoc["State/Region"] != nil {
-			whereStr = fmt.Sprintf("%v AND connection_spec.client_geolocation.region=\"%v\"", whereStr, clientLoc["State/Region"])
+/*
+ * The region of loc as NDT geolocation names it. US states are named in
+ * full, so a postal abbreviation or FIPS code is expanded.
+ */
+func regionName(loc *model.Location) string {
+	if loc.Country == "United States" {
+		if state, ok := census.DefaultGazetteer.State(loc.Region); ok {
+			return state.Name
 		}
-		if clientLoc["City"] != nil {
-			whereStr = fmt.Sprintf("%v AND connection_spec.client_geolocation.city=\"%v\"", whereStr, clientLoc["City"])
+	}
+	return loc.Region
+}
+
+func snapField(field string) bqsql.Expr {
+	return bqsql.Field(fmt.Sprintf("web100_log_entry.snap.%v", field))
+}
+
+/*
+ * Returns the condition selecting the tests at level around loc, or
+ * bqsql.False when loc does not name that level.
+ */
+func (ndt *NDT) getLevelCondition(loc *model.Location, level int) bqsql.Expr {
+	if (level == cityLevel && loc.City == "") ||
+		(level == regionLevel && loc.Region == "") ||
+		(level == countryLevel && loc.Country == "") {
+		return bqsql.False
+	}
+
+	conditions := []bqsql.Expr{}
+	if level <= countryLevel && loc.Country != "" {
+		conditions = append(conditions, bqsql.Eq(clientCountryField, bqsql.String(loc.Country)))
+	}
+	if level <= regionLevel && loc.Region != "" {
+		conditions = append(conditions, bqsql.Eq(clientRegionField, bqsql.String(regionName(loc))))
+	}
+	if level <= cityLevel && loc.City != "" {
+		conditions = append(conditions, bqsql.Eq(clientCityField, bqsql.String(loc.City)))
+	}
+	return bqsql.And(conditions...)
+}
+
+/*
+ * Restricts the tests to those run against the M-Lab servers at serverLoc,
+ * by geolocation and by the site or metro in the server hostname
+ * (e.g. ndt.iupui.mlab1.lga01.measurement-lab.org).
+ */
+func (ndt *NDT) getQueryWhere(serverLoc *model.Location) []bqsql.Expr {
+	conditions := []bqsql.Expr{}
+	if serverLoc.Country != "" {
+		conditions = append(conditions, bqsql.Eq(serverCountryField, bqsql.String(serverLoc.Country)))
+	}
+	if serverLoc.Region != "" {
+		conditions = append(conditions, bqsql.Eq(serverRegionField, bqsql.String(regionName(serverLoc))))
+	}
+	if serverLoc.City != "" {
+		conditions = append(conditions, bqsql.Eq(serverCityField, bqsql.String(serverLoc.City)))
+	}
+	if serverLoc.Metro != "" {
+		pattern := fmt.Sprintf(`\.%v[0-9]+\.`, regexp.QuoteMeta(serverLoc.Metro))
+		conditions = append(conditions, bqsql.RegexpMatch(serverHostField, pattern))
+	}
+	if serverLoc.Site != "" {
+		pattern := fmt.Sprintf(`\.%v\.`, regexp.QuoteMeta(serverLoc.Site))
+		conditions = append(conditions, bqsql.RegexpMatch(serverHostField, pattern))
+	}
+	return conditions
+}
+
+// Returns the condition of the most specific level loc names
+func (ndt *NDT) getLocalCondition(loc *model.Location) bqsql.Expr {
+	for level := cityLevel; level < worldLevel; level++ {
+		if condition := ndt.getLevelCondition(loc, level); condition != bqsql.False {
+			return condition
 		}
 	}
+	return ndt.getLevelCondition(loc, worldLevel)
+}
 
-	if len(whereStr) > 0 {
-		whereStr = fmt.Sprintf("WHERE %v", whereStr)
+// Limits an aggregated expression to the rows matching condition
+func onlyIf(condition bqsql.Expr, expr bqsql.Expr) bqsql.Expr {
+	if condition == "" {
+		return expr
 	}
-	return whereStr
+	return bqsql.If(condition, expr, bqsql.Null)
 }
 
-func (ndt *NDT) getQueryFields(fields []string) string {
+func countIf(condition bqsql.Expr) bqsql.Expr {
+	if condition == "" {
+		return bqsql.Count()
+	}
+	return bqsql.Func("SUM", bqsql.If(condition, bqsql.Int(1), bqsql.Int(0)))
+}
 
-	fieldPart := "SELECT count(*)"
+/*
+ * Selects, in order, the sample size and the average and standard deviation
+ * of each field at the client's location, followed by its distribution for
+ * the fields in distributions, then the sample size and the averages of the
+ * metricFields at every comparison level. parseRows reads them back in that
+ * order.
+ */
+func (ndt *NDT) getQueryFields(fields []string, distributions []string, clientLoc *model.Location) []bqsql.Expr {
+
+	local := ndt.getLocalCondition(clientLoc)
+	withDistribution := fieldSet(distributions)
+	columns := []bqsql.Expr{countIf(local)}
 	for _, field := range fields {
-		field = fmt.Sprintf("web100_log_entry.snap.%v", field)
-		fieldPart = fmt.Sprintf("%v, AVG(%v), STDDEV(%v)", fieldPart, field, field)
+		expr := onlyIf(local, snapField(field))
+		columns = append(columns, bqsql.Func("AVG", expr), bqsql.Func("STDDEV", expr))
+		if withDistribution[field] {
+			columns = append(columns, getDistributionFields(expr)...)
+		}
 	}
-	return fieldPart
+	for level := 0; level < levelCount; level++ {
+		condition := ndt.getLevelCondition(clientLoc, level)
+		columns = append(columns, countIf(condition))
+		for _, field := range metricFields {
+			columns = append(columns, bqsql.Func("AVG", onlyIf(condition, snapField(field))))
+		}
+	}
+	return columns
 }
 
-func (ndt *NDT) getQueryTable(year int, month int) string {
+func (ndt *NDT) getQueryTable(year int, month int) bqsql.Expr {
+	return bqsql.Table(ndt.DatasetID, fmt.Sprintf("%d_%02d", year, month))
+}
 
-	monthStr := fmt.Sprintf("%v", month)
-	for i := 0; i < 2-len(monthStr); i++ {
-		monthStr = fmt.Sprintf("0%v", monthStr)
+// Returns the monthly tables holding the tests of timeRange
+func (ndt *NDT) getQueryTables(timeRange *TimeRange) []bqsql.Expr {
+	tables := []bqsql.Expr{}
+	for _, month := range timeRange.Months() {
+		tables = append(tables, ndt.getQueryTable(month.Year(), int(month.Month())))
 	}
-	tablePart := fmt.Sprintf("FROM %v.%v_%v", ndt.DatasetID, year, monthStr)
-	return tablePart
+	return tables
 }
 
-func (ndt *NDT) parseRows(fields []string, rows []*bigquery.TableRow, result map[string]interface{}) {
+// BigQuery returns every cell as a string, empty for NULL aggregates
+func cellFloat(cell *bigquery.TableRowF) float64 {
+	val, err := strconv.ParseFloat(cell.V, 64)
+	if err != nil {
+		return 0
+	}
+	return val
+}
+
+func (ndt *NDT) parseRows(fields []string, distributions []string, rows []*bigquery.TableRow, result *model.NetworkData) {
 
 	if len(rows) > 0 {
 		row := rows[0].F
-		result["sample size"] = row[0].V
-		for pos, fieldName := range fields {
-			stats := make(map[string]interface{})
-			stats["average"] = row[2*pos+1].V
-			stats["stdev"] = row[2*pos+2].V
-			result[fieldName] = stats
+		result.SampleSize = int64(cellFloat(row[0]))
+		withDistribution := fieldSet(distributions)
+		pos := 1
+		for _, fieldName := range fields {
+			stats := &model.FieldStats{
+				Average: cellFloat(row[pos]),
+				Stdev:   cellFloat(row[pos+1]),
+			}
+			pos += 2
+			if withDistribution[fieldName] {
+				stats.Distribution = parseDistribution(row[pos:])
+				pos += distributionColumns
+			}
+			result.Fields[fieldName] = stats
+		}
+
+		levelMetrics := make([]map[string]float64, levelCount)
+		for level := range levelMetrics {
+			samples := cellFloat(row[pos])
+			pos++
+			averages := make(map[string]float64)
+			if samples == 0 {
+				pos += len(metricFields)
+				levelMetrics[level] = averages
+				continue
+			}
+			for _, fieldName := range metricFields {
+				averages[fieldName] = cellFloat(row[pos])
+				pos++
+			}
+			levelMetrics[level] = computeMetrics(averages)
 		}
+		result.Metrics = compareMetrics(levelMetrics)
+		result.Derived = computeDerived(result.Fields)
 	}
 
 }
 
-func (ndt *NDT) GetData(r *http.Request, fields []string, year int, month int, clientLoc map[string]interface{}, serverLoc map[string]interface{}) (map[string]interface{}, error) {
+func (ndt *NDT) GetData(r *http.Request, fields []string, distributions []string, timeRange *TimeRange, clientLoc *model.Location, serverLoc *model.Location) (*model.Result, error) {
 
-	result := make(map[string]interface{})
-
-	fieldPart := ndt.getQueryFields(fields)
-	tablePart := ndt.getQueryTable(year, month)
-	wherePart := ndt.getQueryWhere(clientLoc, serverLoc)
+	if network, ok := ndt.precomputed(r, fields, distributions, timeRange, clientLoc, serverLoc); ok {
+		return &model.Result{Network: network}, nil
+	}
 
-	query := fmt.Sprintf("%v %v %v", fieldPart, tablePart, wherePart)
+	query := bqsql.Select(ndt.getQueryFields(fields, distributions, clientLoc)...).
+		From(ndt.getQueryTables(timeRange)...).
+		Where(timeRange.condition()).
+		Where(ndt.getQueryWhere(serverLoc)...).
+		String()
 
 	queryResponse, err := ndt.askBigQuery(r, query)
 
 	if err != nil {
-		return nil, err
+		return nil, model.NewSourceError(model.ErrCodeUnavailable, err)
 	}
-	dataResult := make(map[string]interface{})
-	dataResult["complete"] = queryResponse.JobComplete
-	if queryResponse.JobComplete && queryResponse.TotalRows > 0 {
-		ndt.parseRows(fields, queryResponse.Rows, dataResult)
-	} else {
-		dataResult["jobID"] = queryResponse.JobReference.JobId
+	dataResult := model.NewNetworkData()
+	dataResult.Start = timeRange.Start
+	dataResult.End = timeRange.End
+	dataResult.Complete = queryResponse.JobComplete
+	if queryResponse.JobComplete {
+		ndt.parseRows(fields, distributions, queryResponse.Rows, dataResult)
+		return &model.Result{Network: dataResult}, nil
 	}
-	result["network data"] = dataResult
-	return result, nil
+
+	job := newJobHandle(queryResponse.JobReference.JobId, model.JobData, timeRange, clientLoc, serverLoc)
+	job.Fields = fields
+	job.Distributions = distributions
+	if err := submitJob(r, job); err != nil {
+		return nil, err
+	}
+	dataResult.JobID = job.ID
+	dataResult.Job = job
+	return &model.Result{Network: dataResult}, nil
 }
 
 func (ndt *NDT) askBigQuery(r *http.Request, query string) (*bigquery.QueryResponse, error) {
@@ -160,7 +330,7 @@ func (ndt *NDT) askBigQuery(r *http.Request, query string) (*bigquery.QueryRespo
 
 }
 
-func (ndt *NDT) JobResult(r *http.Request, jobID string) (map[string]interface{}, error) {
+func (ndt *NDT) getQueryResults(r *http.Request, jobID string, startIndex uint64, maxResults int64) (*bigquery.GetQueryResultsResponse, error) {
 
 	client := getJWTClient(r)
 	bigqueryService, err := bigquery.New(client)
@@ -169,26 +339,83 @@ func (ndt *NDT) JobResult(r *http.Request, jobID string) (map[string]interface{}
 	}
 
 	jobsService := bigqueryService.Jobs.GetQueryResults(ndt.ProjectID, jobID)
-	jobsService.TimeoutMs(5000)
-	response, err := jobsService.Do()
+	jobsService.StartIndex(startIndex)
+	jobsService.MaxResults(maxResults)
+	jobsService.TimeoutMs(int64(JobResultsTimeout / time.Millisecond))
+	return jobsService.Do()
+}
+
+func (ndt *NDT) Name() string {
+	return "NDT"
+}
+
+func (ndt *NDT) Provides() []string {
+	return DefaultFields
+}
+
+// BigQuery hands back a jobID for slow queries, so allow it longer than the
+// census sources before giving up on it.
+func (ndt *NDT) Deadline() time.Duration {
+	return 40 * time.Second
+}
+
+// Tests keep arriving for recent months, so aggregates only stay fresh for hours
+func (ndt *NDT) CacheTTL() time.Duration {
+	return 6 * time.Hour
+}
+
+/*
+ * Normalizes a query to its fields, the days its time range covers, its
+ * trend and its locations. Queries with invalid parameters are not cached.
+ */
+func (ndt *NDT) CacheKey(r *http.Request, clientLoc *model.Location, serverLoc *model.Location) (string, bool) {
+	timeRange, err := ParseTimeRange(r, time.Now())
 	if err != nil {
-		return nil, err
+		return "", false
 	}
-
-	result := make(map[string]interface{})
-	result["complete"] = response.JobComplete
-	if response.JobComplete {
-		ndt.parseRows(DefaultFields, response.Rows, result)
-	} else {
-		result["jobID"] = response.JobReference.JobId
+	period, months, err := ParseTrend(r)
+	if err != nil {
+		return "", false
 	}
-	return result, nil
-
+	distributions, err := ParseDistributions(r)
+	if err != nil {
+		return "", false
+	}
+	return strings.Join([]string{
+		strings.Join(DefaultFields, ","),
+		strings.Join(distributions, ","),
+		timeRange.Start.Format(DateFormat),
+		timeRange.End.Format(DateFormat),
+		period,
+		strconv.Itoa(months),
+		cache.LocationKey(clientLoc),
+		cache.LocationKey(serverLoc),
+	}, "|"), true
 }
 
-func (ndt *NDT) Query(r *http.Request, clientLoc map[string]interface{}, serverLoc map[string]interface{}) (map[string]interface{}, error) {
+func (ndt *NDT) Query(r *http.Request, clientLoc *model.Location, serverLoc *model.Location) (*model.Result, error) {
 
 	fields := DefaultFields
-	year, month, _ := time.Now().Date()
-	return ndt.GetData(r, fields, year, int(month), clientLoc, serverLoc)
+	timeRange, err := ParseTimeRange(r, time.Now())
+	if err != nil {
+		return nil, model.NewSourceError(model.ErrCodeInvalidRequest, err)
+	}
+	period, months, err := ParseTrend(r)
+	if err != nil {
+		return nil, model.NewSourceError(model.ErrCodeInvalidRequest, err)
+	}
+	distributions, err := ParseDistributions(r)
+	if err != nil {
+		return nil, model.NewSourceError(model.ErrCodeInvalidRequest, err)
+	}
+
+	result, err := ndt.GetData(r, fields, distributions, timeRange, clientLoc, serverLoc)
+	if err != nil || period == "" {
+		return result, err
+	}
+	result.Network.Trend, err = ndt.GetTrend(r, period, months, timeRange, clientLoc, serverLoc)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
 }
@@ -0,0 +1,108 @@
+package ndt
+
+// Unit tests for building the NDT queries.
+
+import (
+	"impact/data/bqsql"
+	"impact/data/model"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// Builds the WHERE clause getQueryWhere produces for serverLoc.
+func testWhere(serverLoc *model.Location) string {
+	return string(bqsql.And(NDT_Source().getQueryWhere(serverLoc)...))
+}
+
+// Makes sure that the server location restricts the tests queried.
+func TestGetQueryWhere(t *testing.T) {
+	if where := testWhere(&model.Location{}); where != "" {
+		t.Errorf("TestGetQueryWhere unrestricted = %v", where)
+	}
+
+	where := testWhere(&model.Location{Country: "United States", Metro: "lga"})
+	expected := `(connection_spec.server_geolocation.country_name="United States") AND (REGEXP_MATCH(connection_spec.server_hostname, "\\.lga[0-9]+\\."))`
+	if where != expected {
+		t.Errorf("TestGetQueryWhere metro = %v", where)
+	}
+
+	where = testWhere(&model.Location{Site: "lga01"})
+	expected = `REGEXP_MATCH(connection_spec.server_hostname, "\\.lga01\\.")`
+	if where != expected {
+		t.Errorf("TestGetQueryWhere site = %v", where)
+	}
+}
+
+// Makes sure that quotes in a location cannot end the string they are in.
+func TestQueryEscapesLocation(t *testing.T) {
+	ndt := NDT_Source()
+	clientLoc := &model.Location{Country: "United States", City: `Coeur d'Alene" OR "1"="1`}
+	serverLoc := &model.Location{City: `Paris\`}
+
+	query := bqsql.Select(ndt.getQueryFields([]string{"MinRTT"}, []string{"MinRTT"}, clientLoc)...).
+		From(ndt.getQueryTable(2012, 6)).
+		Where(ndt.getQueryWhere(serverLoc)...).
+		String()
+
+	if !strings.Contains(query, `city="Coeur d'Alene\" OR \"1\"=\"1"`) {
+		t.Errorf("TestQueryEscapesLocation client city not escaped in %v", query)
+	}
+	if !strings.Contains(query, ` FROM [m_lab.2012_06] WHERE connection_spec.server_geolocation.city="Paris\\"`) {
+		t.Errorf("TestQueryEscapesLocation server city not escaped in %v", query)
+	}
+}
+
+// Makes sure that the distribution columns read the expected quantiles.
+func TestGetDistributionFields(t *testing.T) {
+	columns := getDistributionFields(bqsql.Field("web100_log_entry.snap.MinRTT"))
+	if len(columns) != distributionColumns {
+		t.Fatalf("TestGetDistributionFields got %v columns", len(columns))
+	}
+	expected := "NTH(51, QUANTILES(web100_log_entry.snap.MinRTT, 101))"
+	if string(columns[5]) != expected {
+		t.Errorf("TestGetDistributionFields median = %v, expected %v", columns[5], expected)
+	}
+}
+
+// Makes sure that distributions are only computed for the fields asked for.
+func TestGetQueryFieldsDistributions(t *testing.T) {
+	ndt := NDT_Source()
+	clientLoc := &model.Location{Country: "Canada"}
+	without := ndt.getQueryFields([]string{"MinRTT", "MaxRTT"}, []string{}, clientLoc)
+	with := ndt.getQueryFields([]string{"MinRTT", "MaxRTT"}, []string{"MaxRTT"}, clientLoc)
+	if len(with)-len(without) != distributionColumns {
+		t.Errorf("TestGetQueryFieldsDistributions got %v columns with one distribution, %v without", len(with), len(without))
+	}
+	quantiles := 0
+	for _, column := range with {
+		if strings.Contains(string(column), "QUANTILES(") {
+			quantiles++
+		}
+	}
+	if quantiles != distributionColumns {
+		t.Errorf("TestGetQueryFieldsDistributions got %v quantile columns", quantiles)
+	}
+}
+
+// Makes sure that requests name known fields and default to the headline inputs.
+func TestParseDistributions(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected string
+		ok       bool
+	}{
+		{"", strings.Join(DefaultDistributionFields, ","), true},
+		{"distributions=MinRTT,+CurMSS,MinRTT", "CurMSS,MinRTT", true},
+		{"distributions=none", "", true},
+		{"distributions=Bogus", "", false},
+		{"distributions=" + strings.Join(DefaultFields[:MaxDistributionFields+1], ","), "", false},
+	}
+	for _, test := range tests {
+		r, _ := http.NewRequest("GET", "/query?"+test.query, nil)
+		fields, err := ParseDistributions(r)
+		if (err == nil) != test.ok || (err == nil && strings.Join(fields, ",") != test.expected) {
+			t.Errorf("TestParseDistributions(%v) got %v, %v", test.query, fields, err)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package ndt
+
+import (
+	"errors"
+	"fmt"
+	"impact/data/bqsql"
+	"net/http"
+	"time"
+)
+
+// Format of the start and end query parameters
+const DateFormat = "2006-01-02"
+
+var (
+	// Range queried when the request does not give one
+	DefaultWindow = 30 * 24 * time.Hour
+	// Longest range a single query may cover
+	MaxWindow = 366 * 24 * time.Hour
+	// Month of the oldest m_lab table
+	FirstTableMonth = time.Date(2009, time.February, 1, 0, 0, 0, 0, time.UTC)
+)
+
+var (
+	errTimeRangeOrder  = errors.New("Time range end is not after its start")
+	errTimeRangeLength = errors.New("Time range is too long")
+)
+
+var logTimeField = bqsql.Field("web100_log_entry.log_time")
+
+// The tests queried, from Start up to but not including End
+type TimeRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+func parseDate(r *http.Request, name string) (time.Time, bool, error) {
+	str := r.FormValue(name)
+	if str == "" {
+		return time.Time{}, false, nil
+	}
+	date, err := time.Parse(DateFormat, str)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("Unparseable %v date %q", name, str)
+	}
+	return date, true, nil
+}
+
+/*
+ * Reads the start and end dates (YYYY-MM-DD, end inclusive) of a request.
+ * Missing dates default to a DefaultWindow ending now, and the range is
+ * clipped to the tables that exist.
+ */
+func ParseTimeRange(r *http.Request, now time.Time) (*TimeRange, error) {
+	start, hasStart, err := parseDate(r, "start")
+	if err != nil {
+		return nil, err
+	}
+	end, hasEnd, err := parseDate(r, "end")
+	if err != nil {
+		return nil, err
+	}
+
+	now = now.UTC()
+	if hasEnd {
+		end = end.Add(24 * time.Hour)
+	}
+	if !hasEnd || end.After(now) {
+		end = now
+	}
+	if !hasStart {
+		start = end.Add(-DefaultWindow)
+	}
+	if start.Before(FirstTableMonth) {
+		start = FirstTableMonth
+	}
+
+	if !end.After(start) {
+		return nil, errTimeRangeOrder
+	}
+	if end.Sub(start) > MaxWindow {
+		return nil, errTimeRangeLength
+	}
+	return &TimeRange{Start: start, End: end}, nil
+}
+
+// Returns the first of each month the range touches
+func (tr *TimeRange) Months() []time.Time {
+	months := []time.Time{}
+	month := time.Date(tr.Start.Year(), tr.Start.Month(), 1, 0, 0, 0, 0, time.UTC)
+	for month.Before(tr.End) {
+		months = append(months, month)
+		month = month.AddDate(0, 1, 0)
+	}
+	return months
+}
+
+// Whether the range starts and ends on the first of a month
+func (tr *TimeRange) wholeMonths() bool {
+	for _, t := range []time.Time{tr.Start, tr.End} {
+		if t.Day() != 1 || !t.Equal(t.Truncate(24*time.Hour)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Limits the tests to those logged within the range
+func (tr *TimeRange) condition() bqsql.Expr {
+	return bqsql.And(
+		bqsql.Compare(logTimeField, ">=", bqsql.Int(tr.Start.Unix())),
+		bqsql.Compare(logTimeField, "<", bqsql.Int(tr.End.Unix())),
+	)
+}
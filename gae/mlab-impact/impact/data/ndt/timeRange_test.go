@@ -0,0 +1,69 @@
+package ndt
+
+// Unit tests for the time range of NDT queries.
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+var testNow = time.Date(2012, time.July, 3, 12, 0, 0, 0, time.UTC)
+
+func testTimeRange(t *testing.T, params string) (*TimeRange, error) {
+	r, err := http.NewRequest("GET", "/query?"+params, nil)
+	if err != nil {
+		t.Fatalf("http.NewRequest err = %v", err)
+	}
+	return ParseTimeRange(r, testNow)
+}
+
+// Makes sure that a missing range defaults to the trailing window.
+func TestParseTimeRangeDefault(t *testing.T) {
+	tr, err := testTimeRange(t, "")
+	if err != nil {
+		t.Fatalf("TestParseTimeRangeDefault err = %v", err)
+	}
+	if !tr.End.Equal(testNow) || !tr.Start.Equal(testNow.Add(-DefaultWindow)) {
+		t.Errorf("TestParseTimeRangeDefault got %v to %v", tr.Start, tr.End)
+	}
+	months := tr.Months()
+	if len(months) != 2 || months[0].Month() != time.June || months[1].Month() != time.July {
+		t.Errorf("TestParseTimeRangeDefault months = %v", months)
+	}
+}
+
+// Makes sure that the end date is inclusive and the range spans every month.
+func TestParseTimeRangeDates(t *testing.T) {
+	tr, err := testTimeRange(t, "start=2011-11-15&end=2012-02-01")
+	if err != nil {
+		t.Fatalf("TestParseTimeRangeDates err = %v", err)
+	}
+	if !tr.End.Equal(time.Date(2012, time.February, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("TestParseTimeRangeDates end = %v", tr.End)
+	}
+	months := tr.Months()
+	if len(months) != 4 || months[0].Year() != 2011 || months[3].Month() != time.February {
+		t.Errorf("TestParseTimeRangeDates months = %v", months)
+	}
+
+	tables := NDT_Source().getQueryTables(tr)
+	if len(tables) != 4 || tables[0] != "[m_lab.2011_11]" || tables[3] != "[m_lab.2012_02]" {
+		t.Errorf("TestParseTimeRangeDates tables = %v", tables)
+	}
+}
+
+// Makes sure that bad ranges are refused.
+func TestParseTimeRangeErrors(t *testing.T) {
+	bad := []string{
+		"start=yesterday",
+		"end=2012-13-01",
+		"start=2012-06-10&end=2012-06-01",
+		"start=2010-01-01&end=2012-01-01",
+	}
+	for _, params := range bad {
+		if _, err := testTimeRange(t, params); err == nil {
+			t.Errorf("TestParseTimeRangeErrors accepted %v", params)
+		}
+	}
+}
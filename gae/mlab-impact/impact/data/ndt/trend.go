@@ -0,0 +1,134 @@
+package ndt
+
+import (
+	"code.google.com/p/google-api-go-client/bigquery/v2"
+	"fmt"
+	"impact/data/bqsql"
+	"impact/data/model"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Periods a trend can be grouped by
+const (
+	MonthPeriod = "month"
+	WeekPeriod  = "week"
+)
+
+var (
+	// Months a trend covers when the request does not say
+	DefaultTrendMonths = 6
+	// Most months a trend may cover
+	MaxTrendMonths = 12
+)
+
+var periodField = bqsql.Field("period")
+
+/*
+ * Reads the trend (month or week) and trendMonths parameters of a request.
+ * Returns an empty period when no trend was asked for.
+ */
+func ParseTrend(r *http.Request) (string, int, error) {
+	period := r.FormValue("trend")
+	switch period {
+	case "":
+		return "", 0, nil
+	case MonthPeriod, WeekPeriod:
+	default:
+		return "", 0, fmt.Errorf("Unknown trend period %q", period)
+	}
+
+	months := DefaultTrendMonths
+	if str := r.FormValue("trendMonths"); str != "" {
+		var err error
+		months, err = strconv.Atoi(str)
+		if err != nil || months < 1 || months > MaxTrendMonths {
+			return "", 0, fmt.Errorf("trendMonths must be between 1 and %v", MaxTrendMonths)
+		}
+	}
+	return period, months, nil
+}
+
+// Returns the range covering the months calendar months up to end
+func trendTimeRange(end time.Time, months int) *TimeRange {
+	start := time.Date(end.Year(), end.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, 1-months, 0)
+	if start.Before(FirstTableMonth) {
+		start = FirstTableMonth
+	}
+	return &TimeRange{Start: start, End: end}
+}
+
+// Truncates the test log time to the start of its period, in microseconds
+func periodStart(period string) bqsql.Expr {
+	usec := bqsql.Func("TIMESTAMP_TO_USEC", bqsql.Func("SEC_TO_TIMESTAMP", logTimeField))
+	if period == WeekPeriod {
+		// weeks start on Monday
+		return bqsql.Func("UTC_USEC_TO_WEEK", usec, bqsql.Int(1))
+	}
+	return bqsql.Func("UTC_USEC_TO_MONTH", usec)
+}
+
+/*
+ * Selects the start of each period, its sample size and the averages of the
+ * metricFields at the client's location, one row per period in order.
+ * parseTrendRows reads them back.
+ */
+func (ndt *NDT) getTrendQuery(period string, timeRange *TimeRange, clientLoc *model.Location, serverLoc *model.Location) string {
+	columns := []bqsql.Expr{bqsql.As(periodStart(period), "period"), bqsql.Count()}
+	for _, field := range metricFields {
+		columns = append(columns, bqsql.Func("AVG", snapField(field)))
+	}
+
+	return bqsql.Select(columns...).
+		From(ndt.getQueryTables(timeRange)...).
+		Where(timeRange.condition(), ndt.getLocalCondition(clientLoc)).
+		Where(ndt.getQueryWhere(serverLoc)...).
+		GroupBy(periodField).
+		OrderBy(periodField).
+		String()
+}
+
+func (ndt *NDT) parseTrendRows(rows []*bigquery.TableRow, trend *model.Trend) {
+	for _, row := range rows {
+		if row.F[0].V == "" {
+			continue
+		}
+		usec := int64(cellFloat(row.F[0]))
+		averages := make(map[string]float64)
+		for pos, fieldName := range metricFields {
+			averages[fieldName] = cellFloat(row.F[pos+2])
+		}
+		trend.Points = append(trend.Points, &model.TrendPoint{
+			Start:      time.Unix(0, usec*1000).UTC(),
+			SampleSize: int64(cellFloat(row.F[1])),
+			Metrics:    computeMetrics(averages),
+		})
+	}
+}
+
+// Runs the trend query for the months leading up to the end of timeRange
+func (ndt *NDT) GetTrend(r *http.Request, period string, months int, timeRange *TimeRange, clientLoc *model.Location, serverLoc *model.Location) (*model.Trend, error) {
+	trendRange := trendTimeRange(timeRange.End, months)
+	query := ndt.getTrendQuery(period, trendRange, clientLoc, serverLoc)
+
+	queryResponse, err := ndt.askBigQuery(r, query)
+	if err != nil {
+		return nil, model.NewSourceError(model.ErrCodeUnavailable, err)
+	}
+	trend := model.NewTrend(period)
+	trend.Complete = queryResponse.JobComplete
+	if queryResponse.JobComplete {
+		ndt.parseTrendRows(queryResponse.Rows, trend)
+		return trend, nil
+	}
+
+	job := newJobHandle(queryResponse.JobReference.JobId, model.JobTrend, trendRange, clientLoc, serverLoc)
+	job.Period = period
+	if err := submitJob(r, job); err != nil {
+		return nil, err
+	}
+	trend.JobID = job.ID
+	trend.Job = job
+	return trend, nil
+}
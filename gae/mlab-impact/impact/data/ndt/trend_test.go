@@ -0,0 +1,82 @@
+package ndt
+
+// Unit tests for the NDT metric trends.
+
+import (
+	"impact/data/model"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// Makes sure that the trend parameters are checked.
+func TestParseTrend(t *testing.T) {
+	tests := map[string]int{
+		"":                          0,
+		"trend=month":               DefaultTrendMonths,
+		"trend=week&trendMonths=3":  3,
+		"trend=day":                 -1,
+		"trend=month&trendMonths=0": -1,
+		"trend=week&trendMonths=x":  -1,
+	}
+	for params, expected := range tests {
+		r, _ := http.NewRequest("GET", "/query?"+params, nil)
+		_, months, err := ParseTrend(r)
+		if expected < 0 {
+			if err == nil {
+				t.Errorf("TestParseTrend accepted %v", params)
+			}
+		} else if err != nil || months != expected {
+			t.Errorf("TestParseTrend(%v) = %v, %v", params, months, err)
+		}
+	}
+}
+
+// Makes sure that a trend covers whole calendar months.
+func TestTrendTimeRange(t *testing.T) {
+	tr := trendTimeRange(testNow, 3)
+	if !tr.Start.Equal(time.Date(2012, time.May, 1, 0, 0, 0, 0, time.UTC)) || !tr.End.Equal(testNow) {
+		t.Errorf("TestTrendTimeRange got %v to %v", tr.Start, tr.End)
+	}
+}
+
+// Makes sure that the trend is grouped by period at the client's location.
+func TestGetTrendQuery(t *testing.T) {
+	ndt := NDT_Source()
+	tr := &TimeRange{Start: time.Unix(1335830400, 0), End: time.Unix(1338508800, 0)}
+	query := ndt.getTrendQuery(WeekPeriod, tr, &model.Location{Country: "Canada"}, &model.Location{})
+
+	expected := "SELECT UTC_USEC_TO_WEEK(TIMESTAMP_TO_USEC(SEC_TO_TIMESTAMP(web100_log_entry.log_time)), 1) AS period, COUNT(*), " +
+		"AVG(web100_log_entry.snap.DataOctetsOut), AVG(web100_log_entry.snap.Duration), AVG(web100_log_entry.snap.SumRTT), " +
+		"AVG(web100_log_entry.snap.CountRTT), AVG(web100_log_entry.snap.CongSignals), AVG(web100_log_entry.snap.DataSegsOut) " +
+		"FROM [m_lab.2012_05] " +
+		"WHERE ((web100_log_entry.log_time>=1335830400) AND (web100_log_entry.log_time<1338508800)) AND " +
+		`(connection_spec.client_geolocation.country_name="Canada") ` +
+		"GROUP BY period ORDER BY period"
+	if query != expected {
+		t.Errorf("TestGetTrendQuery got %v", query)
+	}
+}
+
+// Makes sure that every row of a trend becomes a point.
+func TestParseTrendRows(t *testing.T) {
+	ndt := NDT_Source()
+	rows := append(
+		testRow(1335830400000000, 10, 12500000, 10000000, 900, 30, 2, 400),
+		testRow(1338508800000000, 20, 25000000, 10000000, 600, 30, 2, 400)...,
+	)
+
+	trend := model.NewTrend(MonthPeriod)
+	ndt.parseTrendRows(rows, trend)
+
+	if len(trend.Points) != 2 {
+		t.Fatalf("TestParseTrendRows got %v points", len(trend.Points))
+	}
+	second := trend.Points[1]
+	if !second.Start.Equal(time.Unix(1338508800, 0)) || second.SampleSize != 20 {
+		t.Errorf("TestParseTrendRows second point = %+v", second)
+	}
+	if second.Metrics[ThroughputMetric] != 20 || second.Metrics[RTTMetric] != 20 {
+		t.Errorf("TestParseTrendRows second metrics = %v", second.Metrics)
+	}
+}
@@ -0,0 +1,83 @@
+// Package keeps track of the data sources available to impact
+package registry
+
+import (
+	"fmt"
+	"impact/data/model"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+/*
+ * Interface each source must implement
+ *
+ * Each source fills in the parts of a model.Result it is responsible for
+ * and leaves the rest nil so that results can be merged. Name identifies the
+ * source in requests and when reporting its errors, Provides lists the groups
+ * of values it adds to a result.
+ */
+type Source interface {
+	Name() string
+	Provides() []string
+	Query(r *http.Request, clientLoc *model.Location, serverLoc *model.Location) (*model.Result, error)
+}
+
+// A set of sources looked up by case insensitive name
+type Registry struct {
+	mu      sync.RWMutex
+	sources []Source
+	byName  map[string]Source
+}
+
+func New() *Registry {
+	return &Registry{byName: make(map[string]Source)}
+}
+
+// Normalizes a source name for lookups
+func Key(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// Adds a source, panicking if the name is already taken
+func (reg *Registry) Register(source Source) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	key := Key(source.Name())
+	if reg.byName[key] != nil {
+		panic(fmt.Sprintf("registry: source %v registered twice", source.Name()))
+	}
+	reg.byName[key] = source
+	reg.sources = append(reg.sources, source)
+}
+
+// Returns the named source or nil if there is none
+func (reg *Registry) Lookup(name string) Source {
+	reg.mu.RLock()
+	defer reg.mu.RUnlock()
+	return reg.byName[Key(name)]
+}
+
+// Returns every source in the order they were registered
+func (reg *Registry) All() []Source {
+	reg.mu.RLock()
+	defer reg.mu.RUnlock()
+	sources := make([]Source, len(reg.sources))
+	copy(sources, reg.sources)
+	return sources
+}
+
+// The registry sources add themselves to from their init functions
+var Default = New()
+
+func Register(source Source) {
+	Default.Register(source)
+}
+
+func Lookup(name string) Source {
+	return Default.Lookup(name)
+}
+
+func All() []Source {
+	return Default.All()
+}
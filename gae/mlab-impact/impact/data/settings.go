@@ -0,0 +1,81 @@
+package data
+
+import (
+	"appengine"
+	"appengine/datastore"
+
+	"impact/data/registry"
+	"net/http"
+)
+
+const sourceSettingKind = "SourceSetting"
+
+/*
+ * Datastore entity recording whether an administrator has turned a source
+ * off. Sources without a setting are enabled.
+ */
+type SourceSetting struct {
+	Name    string
+	Enabled bool
+}
+
+// What the sources endpoints report about each registered source
+type SourceInfo struct {
+	Name     string   `json:"name"`
+	Provides []string `json:"provides"`
+	Enabled  bool     `json:"enabled"`
+}
+
+// Returns the keys of the sources that have been turned off
+func DisabledSources(r *http.Request) (map[string]bool, error) {
+	c := appengine.NewContext(r)
+	var settings []SourceSetting
+	_, err := datastore.NewQuery(sourceSettingKind).GetAll(c, &settings)
+	if err != nil {
+		return nil, err
+	}
+
+	disabled := make(map[string]bool)
+	for _, setting := range settings {
+		if !setting.Enabled {
+			disabled[registry.Key(setting.Name)] = true
+		}
+	}
+	return disabled, nil
+}
+
+// Turns a registered source on or off for every following query
+func SetSourceEnabled(r *http.Request, name string, enabled bool) error {
+	source := registry.Lookup(name)
+	if source == nil {
+		return errSourceUnknown
+	}
+
+	c := appengine.NewContext(r)
+	key := datastore.NewKey(c, sourceSettingKind, registry.Key(source.Name()), 0, nil)
+	setting := &SourceSetting{
+		Name:    source.Name(),
+		Enabled: enabled,
+	}
+	_, err := datastore.Put(c, key, setting)
+	return err
+}
+
+// Lists every registered source with what it provides and whether it is on
+func DescribeSources(r *http.Request) ([]SourceInfo, error) {
+	disabled, err := DisabledSources(r)
+	if err != nil {
+		return nil, err
+	}
+
+	sources := registry.All()
+	infos := make([]SourceInfo, len(sources))
+	for i, source := range sources {
+		infos[i] = SourceInfo{
+			Name:     source.Name(),
+			Provides: source.Provides(),
+			Enabled:  !disabled[registry.Key(source.Name())],
+		}
+	}
+	return infos, nil
+}
@@ -2,81 +2,172 @@
 package data
 
 import ( // for docs http://golang.org/pkg/ pkgname
-	"impact/data/census/acs"
-	"impact/data/census/sf1"
-	"impact/data/ndt"
+	"appengine"
+	"errors"
+	_ "impact/data/census/acs"
+	_ "impact/data/census/sf1"
+	"impact/data/model"
+	_ "impact/data/ndt"
+	"impact/data/registry"
 	"net/http"
-	"reflect"
+	"time"
 )
 
+//Required network metrics before a query is determined to be complete
+var requiredFields = []string{"Throughput", "RTT", "Packet Loss"}
+
+//How long a source may run before its result is left out of the query
+var DefaultSourceDeadline = 20 * time.Second
+
 /*
- * Interface each source must implement
- * 
- * Results of interfaces are maps of strings to values
- * 
- * The keys identify the title of keys and values should be primitives
- * or maps determing substructures, all of which can be interpreted as
- * JSON objects by json.Marshal
+ * Sources that need a different deadline than DefaultSourceDeadline
+ * implement this interface
  */
-type Source interface {
-	Query(r *http.Request, clientLoc map[string]interface{}, serverLoc map[string]interface{}) (map[string]interface{}, error)
+type DeadlineSource interface {
+	Deadline() time.Duration
 }
 
-//Sources used for querying
-var sources = []Source{acs.ACS_Source(), sf1.SF1_Source(), ndt.NDT_Source()} //TestSource{}} 
+var (
+	errSourceTimeout  = errors.New("Source did not answer before its deadline")
+	errSourceUnknown  = errors.New("No source registered under this name")
+	errSourceDisabled = errors.New("Source has been disabled by an administrator")
+)
 
-//Required fields before a query is determined to be complete
-var requiredFields = []string{"Throughput", "RTT", "Packet Loss"}
+type sourceResponse struct {
+	result *model.Result
+	cache  string
+	err    error
+}
 
-func Query(r *http.Request, clientLoc map[string]interface{}, serverLoc map[string]interface{}) (map[string]interface{}, error) {
-	result := DefaultResult()
-	for i := 0; i < len(sources); i++ {
-		newResult, err := sources[i].Query(r, clientLoc, serverLoc)
+func sourceDeadline(source registry.Source) time.Duration {
+	if ds, ok := source.(DeadlineSource); ok {
+		return ds.Deadline()
+	}
+	return DefaultSourceDeadline
+}
 
-		if err != nil {
-			result["err"] = err.Error()
-			return result, nil
-		}
+func runSource(r *http.Request, source registry.Source, clientLoc *model.Location, serverLoc *model.Location, response chan<- sourceResponse) {
+	result, status, err := cachedQuery(r, ResultCache, source, clientLoc, serverLoc)
+	response <- sourceResponse{result, status, err}
+}
 
-		result = merge(result, newResult)
+//Waits for a source to answer, giving up after remaining has passed
+func awaitSource(response <-chan sourceResponse, remaining time.Duration) (sourceResponse, bool) {
+	//an answer that is already in wins over an expired deadline
+	select {
+	case r := <-response:
+		return r, true
+	default:
+	}
+	if remaining <= 0 {
+		return sourceResponse{}, false
+	}
+	select {
+	case r := <-response:
+		return r, true
+	case <-time.After(remaining):
+		return sourceResponse{}, false
+	}
+}
 
-		if resultIsComplete(result) {
-			return result, nil
+/*
+ * Picks the sources a query runs, either those named or every registered
+ * source when names is empty. Unknown and disabled names are recorded as
+ * errors on result; disabled sources are skipped silently when not named.
+ */
+func selectSources(reg *registry.Registry, names []string, disabled map[string]bool, result *model.Result) []registry.Source {
+	selected := []registry.Source{}
+	if len(names) == 0 {
+		for _, source := range reg.All() {
+			if !disabled[registry.Key(source.Name())] {
+				selected = append(selected, source)
+			}
 		}
+		return selected
 	}
-	result["client"] = clientLoc
-	result["server"] = serverLoc
-	return result, nil
-}
 
-func mergeValues(oldValue interface{}, newValue interface{}) interface{} {
-	if reflect.TypeOf(newValue).String() == "map[string]interface {}" {
-		oldMap, oldOk := oldValue.(map[string]interface{})
-		newMap, newOk := newValue.(map[string]interface{})
-		if oldOk && newOk {
-			merge(oldMap, newMap)
+	for _, source := range reg.All() {
+		for _, name := range names {
+			if registry.Key(name) == registry.Key(source.Name()) {
+				if disabled[registry.Key(name)] {
+					result.AddError(source.Name(), model.NewSourceError(model.ErrCodeDisabled, errSourceDisabled))
+				} else {
+					selected = append(selected, source)
+				}
+				break
+			}
 		}
 	}
-	return oldValue
+	for _, name := range names {
+		if reg.Lookup(name) == nil {
+			result.AddError(name, model.NewSourceError(model.ErrCodeUnknownSource, errSourceUnknown))
+		}
+	}
+	return selected
 }
 
-func merge(oldResult map[string]interface{}, newResult map[string]interface{}) map[string]interface{} {
+/*
+ * Runs the named sources, or all enabled sources if names is empty, and
+ * merges their results. When the settings cannot be read every source is
+ * taken to be enabled, so that the datastore failing does not fail queries.
+ */
+func Query(r *http.Request, names []string, clientLoc *model.Location, serverLoc *model.Location) (*model.Result, error) {
+	disabled, err := DisabledSources(r)
+	if err != nil {
+		c := appengine.NewContext(r)
+		c.Errorf("Query:DisabledSources err = %v", err)
+		disabled = map[string]bool{}
+	}
+	result := DefaultResult()
+	sources := selectSources(registry.Default, names, disabled, result)
+	return querySources(r, sources, clientLoc, serverLoc, result), nil
+}
 
-	for key, value := range newResult {
-		if oldResult[key] == nil {
-			oldResult[key] = value
-		} else {
-			oldResult[key] = mergeValues(oldResult[key], value)
-		}
+/*
+ * Runs every source in parallel and merges their results into result in the
+ * order of sources. A source that fails or misses its deadline is left out,
+ * its error is recorded under its name and the result is marked as partial.
+ */
+func querySources(r *http.Request, sources []registry.Source, clientLoc *model.Location, serverLoc *model.Location, result *model.Result) *model.Result {
+	result.Client = clientLoc
+	result.Server = serverLoc
+	start := time.Now()
+	responses := make([]chan sourceResponse, len(sources))
+	for i, source := range sources {
+		//buffered so sources finishing after an early return do not block
+		responses[i] = make(chan sourceResponse, 1)
+		go runSource(r, source, clientLoc, serverLoc, responses[i])
 	}
 
-	return oldResult
+	for i, source := range sources {
+		response, ok := awaitSource(responses[i], sourceDeadline(source)-time.Since(start))
+		if !ok {
+			result.AddError(source.Name(), model.NewSourceError(model.ErrCodeTimeout, errSourceTimeout))
+			continue
+		}
+		if response.cache != "" {
+			result.SetCacheStatus(source.Name(), response.cache)
+		}
+		if response.err != nil {
+			result.AddError(source.Name(), response.err)
+			continue
+		}
+		result.Merge(response.result)
+
+		if resultIsComplete(result) {
+			return result
+		}
+	}
+	return result
 }
 
-func resultIsComplete(r map[string]interface{}) bool {
+func resultIsComplete(r *model.Result) bool {
 
+	if r.Network == nil {
+		return false
+	}
 	for _, val := range requiredFields {
-		if r[val] == nil {
+		if r.Network.Metrics[val] == nil {
 			return false
 		}
 	}
@@ -84,6 +175,6 @@ func resultIsComplete(r map[string]interface{}) bool {
 	return true
 }
 
-func DefaultResult() map[string]interface{} {
-	return make(map[string]interface{})
+func DefaultResult() *model.Result {
+	return &model.Result{}
 }
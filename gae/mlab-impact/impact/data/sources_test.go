@@ -0,0 +1,186 @@
+package data
+
+// Unit tests for running the sources of a query.
+
+import (
+	"errors"
+	"impact/data/model"
+	"impact/data/registry"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// A source that answers with a fixed result after a delay.
+type testSource struct {
+	name   string
+	result *model.Result
+	err    error
+	delay  time.Duration
+}
+
+func (ts *testSource) Name() string {
+	return ts.name
+}
+
+func (ts *testSource) Provides() []string {
+	return []string{}
+}
+
+func (ts *testSource) Query(r *http.Request, clientLoc *model.Location, serverLoc *model.Location) (*model.Result, error) {
+	time.Sleep(ts.delay)
+	return ts.result, ts.err
+}
+
+// Makes sure that results are merged in source order, not finishing order.
+func TestQueryMergeOrder(t *testing.T) {
+	slow := &model.CensusComparison{}
+	fast := &model.CensusComparison{}
+	sources := []registry.Source{
+		&testSource{result: &model.Result{ACS: slow}, delay: 20 * time.Millisecond},
+		&testSource{result: &model.Result{ACS: fast}},
+	}
+
+	result := querySources(nil, sources, &model.Location{}, &model.Location{}, DefaultResult())
+	if result.ACS != slow {
+		t.Errorf("TestQueryMergeOrder merged the faster source first")
+	}
+	if result.Partial {
+		t.Fail()
+	}
+}
+
+// Makes sure that a source missing its deadline leaves a partial result.
+func TestQueryDeadline(t *testing.T) {
+	deadline := DefaultSourceDeadline
+	DefaultSourceDeadline = 10 * time.Millisecond
+	defer func() {
+		DefaultSourceDeadline = deadline
+	}()
+	network := model.NewNetworkData()
+	sources := []registry.Source{
+		&testSource{name: "ACS", result: &model.Result{ACS: &model.CensusComparison{}}, delay: time.Second},
+		&testSource{result: &model.Result{Network: network}},
+	}
+
+	result := querySources(nil, sources, &model.Location{}, &model.Location{}, DefaultResult())
+	if !result.Partial {
+		t.Errorf("TestQueryDeadline result not marked partial")
+	}
+	if result.ACS != nil {
+		t.Errorf("TestQueryDeadline kept the late source")
+	}
+	if result.Errors["ACS"] == nil || result.Errors["ACS"].Code != model.ErrCodeTimeout {
+		t.Errorf("TestQueryDeadline did not report the timeout")
+	}
+	if result.Network != network {
+		t.Errorf("TestQueryDeadline lost the timely source")
+	}
+}
+
+// Makes sure that a failing source is reported without hiding the others.
+func TestQueryError(t *testing.T) {
+	network := model.NewNetworkData()
+	sources := []registry.Source{
+		&testSource{name: "ACS", err: errors.New("census down")},
+		&testSource{name: "SF1", err: model.NewSourceError(model.ErrCodeUnavailable, errors.New("no route"))},
+		&testSource{name: "NDT", result: &model.Result{Network: network}},
+	}
+
+	result := querySources(nil, sources, &model.Location{}, &model.Location{}, DefaultResult())
+	if result.Network != network {
+		t.Errorf("TestQueryError lost the working source")
+	}
+	if !result.Partial {
+		t.Errorf("TestQueryError result not marked partial")
+	}
+	acsErr := result.Errors["ACS"]
+	if acsErr == nil || acsErr.Code != model.ErrCodeFailed || acsErr.Message != "census down" {
+		t.Errorf("TestQueryError got ACS error %v", acsErr)
+	}
+	sf1Err := result.Errors["SF1"]
+	if sf1Err == nil || sf1Err.Code != model.ErrCodeUnavailable {
+		t.Errorf("TestQueryError got SF1 error %v", sf1Err)
+	}
+}
+
+// Builds a registry holding test sources with the given names.
+func testRegistry(names ...string) *registry.Registry {
+	reg := registry.New()
+	for _, name := range names {
+		reg.Register(&testSource{name: name})
+	}
+	return reg
+}
+
+// Makes sure that every enabled source runs when none are named.
+func TestSelectAllSources(t *testing.T) {
+	reg := testRegistry("ACS", "SF1", "NDT")
+	result := DefaultResult()
+
+	selected := selectSources(reg, []string{}, map[string]bool{"sf1": true}, result)
+	if len(selected) != 2 || selected[0].Name() != "ACS" || selected[1].Name() != "NDT" {
+		t.Errorf("TestSelectAllSources selected %v", selected)
+	}
+	if result.Errors != nil {
+		t.Errorf("TestSelectAllSources reported %v", result.Errors)
+	}
+}
+
+// Makes sure that named sources are picked in registration order.
+func TestSelectNamedSources(t *testing.T) {
+	reg := testRegistry("ACS", "SF1", "NDT")
+	result := DefaultResult()
+
+	selected := selectSources(reg, []string{"ndt", "Acs", "bogus", "sf1"}, map[string]bool{"sf1": true}, result)
+	if len(selected) != 2 || selected[0].Name() != "ACS" || selected[1].Name() != "NDT" {
+		t.Errorf("TestSelectNamedSources selected %v", selected)
+	}
+	if result.Errors["bogus"] == nil || result.Errors["bogus"].Code != model.ErrCodeUnknownSource {
+		t.Errorf("TestSelectNamedSources did not report the unknown source")
+	}
+	if result.Errors["SF1"] == nil || result.Errors["SF1"].Code != model.ErrCodeDisabled {
+		t.Errorf("TestSelectNamedSources did not report the disabled source")
+	}
+}
+
+// Makes sure that completeness is judged on the headline network metrics.
+func TestResultIsComplete(t *testing.T) {
+	result := DefaultResult()
+	if resultIsComplete(result) {
+		t.Fail()
+	}
+	result.Network = model.NewNetworkData()
+	result.Network.Fields["MinRTT"] = &model.FieldStats{}
+	if resultIsComplete(result) {
+		t.Fail()
+	}
+	for _, metric := range requiredFields {
+		result.Network.Metrics[metric] = &model.ComparativeValues{}
+	}
+	if !resultIsComplete(result) {
+		t.Errorf("TestResultIsComplete missed the required metrics")
+	}
+}
+
+// Makes sure that the locations are reported when a complete result ends the query early.
+func TestQueryLocationsOnEarlyExit(t *testing.T) {
+	network := model.NewNetworkData()
+	for _, metric := range requiredFields {
+		network.Metrics[metric] = &model.ComparativeValues{}
+	}
+	sources := []registry.Source{
+		&testSource{name: "NDT", result: &model.Result{Network: network}},
+		&testSource{name: "ACS", result: &model.Result{ACS: &model.CensusComparison{}}},
+	}
+	clientLoc := &model.Location{City: "Durham"}
+	serverLoc := &model.Location{City: "Atlanta"}
+
+	result := querySources(nil, sources, clientLoc, serverLoc, DefaultResult())
+	if result.ACS != nil {
+		t.Errorf("TestQueryLocationsOnEarlyExit did not stop at the complete result")
+	}
+	if result.Client != clientLoc || result.Server != serverLoc {
+		t.Errorf("TestQueryLocationsOnEarlyExit got client %v, server %v", result.Client, result.Server)
+	}
+}
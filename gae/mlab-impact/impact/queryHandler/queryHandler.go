@@ -2,83 +2,100 @@
 package queryHandler
 
 import ( // for docs http://golang.org/pkg/ pkgname
+	"errors"
 	"impact/data"
+	"impact/data/model"
 	"net/http"
+	"regexp"
 	"strconv"
+	"strings"
 )
 
-type ComparativeValues struct {
-	Local float32
-	State float32
-	World float32
-}
+// M-Lab sites are named after the nearest airport, e.g. lga01 in metro lga
+var (
+	sitePattern  = regexp.MustCompile("^[a-z]{3}[0-9]{2}$")
+	metroPattern = regexp.MustCompile("^[a-z]{3}$")
+)
 
-type SearchResult struct {
-	Latitude, Longitude float64
-	City                string
-	State               string
-	Country             string
-	Throughput          ComparativeValues
-	RTT                 ComparativeValues
-	Packet_loss         ComparativeValues
-}
+var (
+	errSite  = errors.New("Unparseable server site")
+	errMetro = errors.New("Unparseable server metro")
+)
 
-func GetResult(r *http.Request) (map[string]interface{}, error) {
+func GetResult(r *http.Request) (*model.Result, error) {
 
 	clientGeolocation, err := location(r, "c")
 	if err != nil {
-		result := make(map[string]interface{})
-		result["error"] = err.Error()
-		return result, nil
+		return &model.Result{Err: err.Error()}, nil
 	}
 	serverGeolocation, err := location(r, "s")
 	if err != nil {
-		result := make(map[string]interface{})
-		result["error"] = err.Error()
-		return result, nil
+		return &model.Result{Err: err.Error()}, nil
 	}
-	return data.Query(r, clientGeolocation, serverGeolocation)
+	if err := serverSite(r, "s", serverGeolocation); err != nil {
+		return nil, model.NewSourceError(model.ErrCodeInvalidRequest, err)
+	}
+	return data.Query(r, sourceNames(r), clientGeolocation, serverGeolocation)
 }
 
-func location(r *http.Request, prefix string) (map[string]interface{}, error) {
+/*
+ * Adds the M-Lab site or metro a server location is restricted to. Fails on
+ * malformed names rather than querying every server in their place.
+ */
+func serverSite(r *http.Request, prefix string, loc *model.Location) error {
+	site := strings.ToLower(r.FormValue(prefix + "Site"))
+	if site != "" {
+		if !sitePattern.MatchString(site) {
+			return errSite
+		}
+		loc.Site = site
+	}
+	metro := strings.ToLower(r.FormValue(prefix + "Metro"))
+	if metro != "" {
+		if !metroPattern.MatchString(metro) {
+			return errMetro
+		}
+		loc.Metro = metro
+	}
+	return nil
+}
+
+// Splits the comma separated sources parameter, e.g. sources=ndt,acs
+func sourceNames(r *http.Request) []string {
+	names := []string{}
+	for _, name := range strings.Split(r.FormValue("sources"), ",") {
+		name = strings.TrimSpace(name)
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func location(r *http.Request, prefix string) (*model.Location, error) {
 	geo := data.DefaultGeolocator()
-	geolocation := make(map[string]interface{})
 
 	qType := r.FormValue(prefix + "Type")
 	if qType == "latlng" {
 		lat, err := strconv.ParseFloat(r.FormValue(prefix+"Lat"), 64)
 		if err != nil {
-			geolocation["Error"] = "Unparseable client latitude"
-			return geolocation, nil
+			return &model.Location{Error: "Unparseable client latitude"}, nil
 		}
 		long, err := strconv.ParseFloat(r.FormValue(prefix+"Long"), 64)
 		if err != nil {
-			geolocation["Error"] = "Unparseable client longitude"
-			return geolocation, nil
+			return &model.Location{Error: "Unparseable client longitude"}, nil
 		}
 		return geo.ByLatLong(r, lat, long)
 	} else if qType == "cityregioncountry" {
 
-		result := make(map[string]interface{})
-
-		result["Country"] = r.FormValue(prefix + "Country")
-		if result["Country"] == "" {
-			delete(result, "Country")
-		}
-		result["Region"] = r.FormValue(prefix + "Region")
-		if result["Region"] == "" {
-			delete(result, "Region")
-		}
-		result["County"] = r.FormValue(prefix + "County")
-		if result["County"] == "" {
-			delete(result, "County")
-		}
-		result["City"] = r.FormValue(prefix + "City")
-		if result["City"] == "" {
-			delete(result, "City")
+		result := &model.Location{
+			Country: r.FormValue(prefix + "Country"),
+			Region:  r.FormValue(prefix + "Region"),
+			County:  r.FormValue(prefix + "County"),
+			City:    r.FormValue(prefix + "City"),
 		}
 
 		return result, nil
 	}
-	return make(map[string]interface{}), nil
+	return &model.Location{}, nil
 }
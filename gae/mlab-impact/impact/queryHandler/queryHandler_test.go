@@ -0,0 +1,29 @@
+package queryHandler
+
+// Unit tests for reading the locations of a query.
+
+import (
+	"impact/data/model"
+	"net/http"
+	"testing"
+)
+
+// Makes sure that well formed sites and metros restrict the server location.
+func TestServerSite(t *testing.T) {
+	r, _ := http.NewRequest("GET", "/query?sSite=LGA01&sMetro=lga", nil)
+	loc := &model.Location{}
+	if err := serverSite(r, "s", loc); err != nil || loc.Site != "lga01" || loc.Metro != "lga" {
+		t.Errorf("TestServerSite got %+v, %v", loc, err)
+	}
+}
+
+// Makes sure that malformed sites and metros fail instead of leaving the server unrestricted.
+func TestServerSiteInvalid(t *testing.T) {
+	for _, query := range []string{"sSite=lga1", "sMetro=nyc01"} {
+		r, _ := http.NewRequest("GET", "/query?"+query, nil)
+		loc := &model.Location{}
+		if err := serverSite(r, "s", loc); err == nil {
+			t.Errorf("TestServerSiteInvalid accepted %v as %+v", query, loc)
+		}
+	}
+}
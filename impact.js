@@ -329,8 +329,12 @@ impact.parseAddress = function(params) {
  */
 impact.onQuerySuccess = function(response) {
 
-  response['client'] = impact.queryResult['client'];
-  response['server'] = impact.queryResult['server'];
+  if (response['client'] == null) {
+    response['client'] = impact.queryResult['client'];
+  }
+  if (response['server'] == null) {
+    response['server'] = impact.queryResult['server'];
+  }
   impact.queryResult = response;
 
   var url = '';
@@ -648,9 +648,9 @@ page.setupPopulationTab = function(result) {
 
 
   if (result['SF1']['client'] != null &&
-      result['SF1']['client']['Asian alone'] != null) {
+      result['SF1']['client']['Race'] != null) {
 
-    data = result['SF1']['client'];
+    data = result['SF1']['client']['Race'];
     var demographicData = [
       [parseInt(data['American Indian and Alaska Native alone']['total'])],
       [parseInt(data['Asian alone']['total'])],
@@ -669,9 +669,9 @@ page.setupPopulationTab = function(result) {
   }
 
   if (result['SF1']['server'] != null &&
-      result['SF1']['server']['Asian alone'] != null) {
+      result['SF1']['server']['Race'] != null) {
 
-    data = result['SF1']['server'];
+    data = result['SF1']['server']['Race'];
     var demographicData = [
       [parseInt(data['American Indian and Alaska Native alone']['total'])],
       [parseInt(data['Asian alone']['total'])],
@@ -738,22 +738,22 @@ page.setupIncomeTab = function(result) {
 
     var data = result['ACS']['client']['Income'];
     var incomeData = [
-      [parseInt(data['Less than $10,000']['total'])],
-      [parseInt(data['$10,000 to $14,999']['total'])],
-      [parseInt(data['$15,000 to $19,999']['total'])],
-      [parseInt(data['$20,000 to $24,999']['total'])],
-      [parseInt(data['$25,000 to $29,999']['total'])],
-      [parseInt(data['$30,000 to $34,999']['total'])],
-      [parseInt(data['$35,000 to $39,999']['total'])],
-      [parseInt(data['$40,000 to $44,999']['total'])],
-      [parseInt(data['$45,000 to $49,999']['total'])],
-      [parseInt(data['$50,000 to $59,999']['total'])],
-      [parseInt(data['$60,000 to $74,999']['total'])],
-      [parseInt(data['$75,000 to $99,999']['total'])],
-      [parseInt(data['$100,000 to $124,999']['total'])],
-      [parseInt(data['$125,000 to $149,999']['total'])],
-      [parseInt(data['$150,000 to $199,999']['total'])],
-      [parseInt(data['$200,000 or more']['total'])]
+      [parseInt(data['Less than $10,000']['estimate'])],
+      [parseInt(data['$10,000 to $14,999']['estimate'])],
+      [parseInt(data['$15,000 to $19,999']['estimate'])],
+      [parseInt(data['$20,000 to $24,999']['estimate'])],
+      [parseInt(data['$25,000 to $29,999']['estimate'])],
+      [parseInt(data['$30,000 to $34,999']['estimate'])],
+      [parseInt(data['$35,000 to $39,999']['estimate'])],
+      [parseInt(data['$40,000 to $44,999']['estimate'])],
+      [parseInt(data['$45,000 to $49,999']['estimate'])],
+      [parseInt(data['$50,000 to $59,999']['estimate'])],
+      [parseInt(data['$60,000 to $74,999']['estimate'])],
+      [parseInt(data['$75,000 to $99,999']['estimate'])],
+      [parseInt(data['$100,000 to $124,999']['estimate'])],
+      [parseInt(data['$125,000 to $149,999']['estimate'])],
+      [parseInt(data['$150,000 to $199,999']['estimate'])],
+      [parseInt(data['$200,000 or more']['estimate'])]
     ];
 
     var incomeDataObj = results.categorizedDataToObject('Income',
@@ -766,22 +766,22 @@ page.setupIncomeTab = function(result) {
 
     var data = result['ACS']['server']['Income'];
     var incomeData = [
-      [parseInt(data['Less than $10,000']['total'])],
-      [parseInt(data['$10,000 to $14,999']['total'])],
-      [parseInt(data['$15,000 to $19,999']['total'])],
-      [parseInt(data['$20,000 to $24,999']['total'])],
-      [parseInt(data['$25,000 to $29,999']['total'])],
-      [parseInt(data['$30,000 to $34,999']['total'])],
-      [parseInt(data['$35,000 to $39,999']['total'])],
-      [parseInt(data['$40,000 to $44,999']['total'])],
-      [parseInt(data['$45,000 to $49,999']['total'])],
-      [parseInt(data['$50,000 to $59,999']['total'])],
-      [parseInt(data['$60,000 to $74,999']['total'])],
-      [parseInt(data['$75,000 to $99,999']['total'])],
-      [parseInt(data['$100,000 to $124,999']['total'])],
-      [parseInt(data['$125,000 to $149,999']['total'])],
-      [parseInt(data['$150,000 to $199,999']['total'])],
-      [parseInt(data['$200,000 or more']['total'])]
+      [parseInt(data['Less than $10,000']['estimate'])],
+      [parseInt(data['$10,000 to $14,999']['estimate'])],
+      [parseInt(data['$15,000 to $19,999']['estimate'])],
+      [parseInt(data['$20,000 to $24,999']['estimate'])],
+      [parseInt(data['$25,000 to $29,999']['estimate'])],
+      [parseInt(data['$30,000 to $34,999']['estimate'])],
+      [parseInt(data['$35,000 to $39,999']['estimate'])],
+      [parseInt(data['$40,000 to $44,999']['estimate'])],
+      [parseInt(data['$45,000 to $49,999']['estimate'])],
+      [parseInt(data['$50,000 to $59,999']['estimate'])],
+      [parseInt(data['$60,000 to $74,999']['estimate'])],
+      [parseInt(data['$75,000 to $99,999']['estimate'])],
+      [parseInt(data['$100,000 to $124,999']['estimate'])],
+      [parseInt(data['$125,000 to $149,999']['estimate'])],
+      [parseInt(data['$150,000 to $199,999']['estimate'])],
+      [parseInt(data['$200,000 or more']['estimate'])]
     ];
 
     var incomeDataObj = results.categorizedDataToObject('Income',